@@ -1,17 +1,23 @@
 package main
 
 import (
+	"context"
 	"net"
 	"order_service/config"
+	pbdr "order_service/genproto/delivery"
 	pbd "order_service/genproto/dish"
 	pbo "order_service/genproto/order"
 	pbp "order_service/genproto/payment"
 	pbr "order_service/genproto/review"
 	"order_service/models"
+	"order_service/pkg/gateway"
 	"order_service/pkg/logger"
+	"order_service/pkg/routing"
+	"order_service/pkg/vault"
 	"order_service/service"
 	"order_service/storage/postgres"
 	"order_service/storage/redis"
+	_ "time/tzdata"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -51,12 +57,37 @@ func main() {
 		return
 	}
 
-	server := grpc.NewServer()
+	cardVault, err := vault.NewLocal(cfg.VAULT_KEY)
+	if err != nil {
+		systemConfig.Logger.Fatal("Failed to create card vault", zap.Error(err))
+		return
+	}
+
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(service.NewAuthInterceptor(systemConfig)),
+		grpc.StreamInterceptor(service.NewStreamAuthInterceptor(systemConfig)),
+	)
+
+	var paymentGateway gateway.PaymentGateway
+	switch cfg.PAYMENT_GATEWAY {
+	case config.GatewayFake:
+		paymentGateway = gateway.NewFake(cardVault)
+	default:
+		systemConfig.Logger.Fatal("Unknown payment gateway", zap.String("gateway", cfg.PAYMENT_GATEWAY))
+		return
+	}
+
+	paymentService := service.NewPaymentService(systemConfig, paymentGateway, cardVault)
 
 	pbd.RegisterDishServer(server, service.NewDishService(systemConfig))
-	pbo.RegisterOrderServer(server, service.NewOrderService(systemConfig))
-	pbp.RegisterPaymentServer(server, service.NewPaymentService(systemConfig))
+	pbo.RegisterOrderServer(server, service.NewOrderService(systemConfig, paymentService))
+	pbp.RegisterPaymentServer(server, paymentService)
 	pbr.RegisterReviewServer(server, service.NewReviewService(systemConfig))
+	pbdr.RegisterDeliveryRouteServer(server, service.NewDeliveryRouteService(systemConfig, routing.NewHaversine()))
+
+	go service.NewOrderScheduler(systemConfig).Run(context.Background())
+	go service.NewOutboxRelay(systemConfig,
+		redis.NewStreamBroker(redisDb, cfg.OUTBOX_STREAM, cfg.OUTBOX_MAX_LEN)).Run(context.Background())
 
 	systemConfig.Logger.Info("Server is Running...")
 	err = server.Serve(listener)
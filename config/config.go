@@ -3,24 +3,58 @@ package config
 import (
 	"log"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
 )
 
+// minVaultKeyLength is the shortest card vault key accepted, in bytes.
+const minVaultKeyLength = 32
+
+const (
+	EnvDevelopment = "development"
+	// GatewayFake approves every charge, so it is only allowed in development.
+	GatewayFake = "fake"
+)
+
 type Config struct {
-	ORDER_SERVICE_PORT string
-	AUTH_SERVICE_PORT  string
-	DB_HOST            string
-	DB_PORT            string
-	DB_NAME            string
-	DB_USER            string
-	DB_PASSWORD        string
-	REDIS_HOST         string
-	REDIS_PORT         string
-	REDIS_PASSWORD     string
-	LOG_PATH           string
-	APP_PASSWORD       string
+	ORDER_SERVICE_PORT       string
+	AUTH_SERVICE_PORT        string
+	DB_HOST                  string
+	DB_PORT                  string
+	DB_NAME                  string
+	DB_USER                  string
+	DB_PASSWORD              string
+	REDIS_HOST               string
+	REDIS_PORT               string
+	REDIS_PASSWORD           string
+	LOG_PATH                 string
+	APP_PASSWORD             string
+	CURRENCY                 string
+	TIME_ZONE                string
+	VAULT_KEY                string
+	IDEMPOTENCY_TTL          time.Duration
+	ACCESS_TOKEN_SECRET      string
+	LOOKUP_CACHE_TTL         time.Duration
+	LOOKUP_WORKERS           int
+	CANCELLATION_FEE_PERCENT int64
+	SLOT_DURATION            time.Duration
+	SLOT_CAPACITY            int
+	SCHEDULE_LEAD_TIME       time.Duration
+	SCHEDULER_INTERVAL       time.Duration
+	KITCHEN_PARALLEL_ORDERS  int
+	DEFAULT_DELIVERY_TIME    time.Duration
+	OUTBOX_STREAM            string
+	OUTBOX_MAX_LEN           int64
+	OUTBOX_BATCH_SIZE        int
+	OUTBOX_RELAY_INTERVAL    time.Duration
+	OUTBOX_LEASE             time.Duration
+	OUTBOX_MAX_ATTEMPTS      int
+	OUTBOX_RETRY_BACKOFF     time.Duration
+	OUTBOX_MAX_BACKOFF       time.Duration
+	ENVIRONMENT              string
+	PAYMENT_GATEWAY          string
 }
 
 func Load() *Config {
@@ -42,10 +76,57 @@ func Load() *Config {
 	config.REDIS_PASSWORD = cast.ToString(coalesce("REDIS_PASSWORD", "root"))
 	config.LOG_PATH = cast.ToString(coalesce("LOG_PATH", "areyouinterested.log"))
 	config.APP_PASSWORD = cast.ToString(coalesce("APP_PASSWORD", "COMMONMAN"))
+	// Rows stored before amounts carried a currency were backfilled as UZS by
+	// migration 000003; databases that predate it must keep CURRENCY at UZS.
+	config.CURRENCY = cast.ToString(coalesce("CURRENCY", "UZS"))
+	// Kitchens have no time zone of their own; all of them share TIME_ZONE for
+	// working hours, delivery slots and statistics.
+	config.TIME_ZONE = cast.ToString(coalesce("TIME_ZONE", "Asia/Tashkent"))
+	config.VAULT_KEY = required("VAULT_KEY")
+	if len(config.VAULT_KEY) < minVaultKeyLength {
+		log.Fatalf("VAULT_KEY must be at least %d bytes long", minVaultKeyLength)
+	}
+	config.IDEMPOTENCY_TTL = cast.ToDuration(coalesce("IDEMPOTENCY_TTL", "24h"))
+	config.ACCESS_TOKEN_SECRET = required("ACCESS_TOKEN_SECRET")
+	config.LOOKUP_CACHE_TTL = cast.ToDuration(coalesce("LOOKUP_CACHE_TTL", "10m"))
+	config.LOOKUP_WORKERS = cast.ToInt(coalesce("LOOKUP_WORKERS", 8))
+	config.CANCELLATION_FEE_PERCENT = cast.ToInt64(coalesce("CANCELLATION_FEE_PERCENT", 20))
+	if config.CANCELLATION_FEE_PERCENT < 0 || config.CANCELLATION_FEE_PERCENT > 100 {
+		log.Fatalf("CANCELLATION_FEE_PERCENT must be between 0 and 100, got %d", config.CANCELLATION_FEE_PERCENT)
+	}
+	config.SLOT_DURATION = cast.ToDuration(coalesce("SLOT_DURATION", "30m"))
+	config.SLOT_CAPACITY = cast.ToInt(coalesce("SLOT_CAPACITY", 10))
+	config.SCHEDULE_LEAD_TIME = cast.ToDuration(coalesce("SCHEDULE_LEAD_TIME", "45m"))
+	config.SCHEDULER_INTERVAL = cast.ToDuration(coalesce("SCHEDULER_INTERVAL", "1m"))
+	config.KITCHEN_PARALLEL_ORDERS = cast.ToInt(coalesce("KITCHEN_PARALLEL_ORDERS", 2))
+	config.DEFAULT_DELIVERY_TIME = cast.ToDuration(coalesce("DEFAULT_DELIVERY_TIME", "15m"))
+	config.OUTBOX_STREAM = cast.ToString(coalesce("OUTBOX_STREAM", "order_service.events"))
+	config.OUTBOX_MAX_LEN = cast.ToInt64(coalesce("OUTBOX_MAX_LEN", 100000))
+	config.OUTBOX_BATCH_SIZE = cast.ToInt(coalesce("OUTBOX_BATCH_SIZE", 100))
+	config.OUTBOX_RELAY_INTERVAL = cast.ToDuration(coalesce("OUTBOX_RELAY_INTERVAL", "1s"))
+	config.OUTBOX_LEASE = cast.ToDuration(coalesce("OUTBOX_LEASE", "1m"))
+	config.OUTBOX_MAX_ATTEMPTS = cast.ToInt(coalesce("OUTBOX_MAX_ATTEMPTS", 10))
+	config.OUTBOX_RETRY_BACKOFF = cast.ToDuration(coalesce("OUTBOX_RETRY_BACKOFF", "1s"))
+	config.OUTBOX_MAX_BACKOFF = cast.ToDuration(coalesce("OUTBOX_MAX_BACKOFF", "10m"))
+	config.ENVIRONMENT = required("ENVIRONMENT")
+	config.PAYMENT_GATEWAY = required("PAYMENT_GATEWAY")
+	if config.PAYMENT_GATEWAY == GatewayFake && config.ENVIRONMENT != EnvDevelopment {
+		log.Fatalf("the fake payment gateway cannot run in the %s environment", config.ENVIRONMENT)
+	}
 
 	return &config
 }
 
+// required returns a setting that has no safe default, such as a secret, and
+// stops the service when it is missing.
+func required(key string) string {
+	val := cast.ToString(coalesce(key, ""))
+	if val == "" {
+		log.Fatalf("%s must be set", key)
+	}
+	return val
+}
+
 func coalesce(key string, value interface{}) interface{} {
 	val, exist := os.LookupEnv(key)
 	if exist {
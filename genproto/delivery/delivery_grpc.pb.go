@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: delivery.proto
+
+package delivery
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DeliveryRouteClient is the client API for DeliveryRoute service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeliveryRouteClient interface {
+	CreateRoute(ctx context.Context, in *ReqRoute, opts ...grpc.CallOption) (*RouteInfo, error)
+	UpdateRoute(ctx context.Context, in *ReqRoute, opts ...grpc.CallOption) (*RouteInfo, error)
+	GetRouteByOrderId(ctx context.Context, in *Id, opts ...grpc.CallOption) (*RouteInfo, error)
+}
+
+type deliveryRouteClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeliveryRouteClient(cc grpc.ClientConnInterface) DeliveryRouteClient {
+	return &deliveryRouteClient{cc}
+}
+
+func (c *deliveryRouteClient) CreateRoute(ctx context.Context, in *ReqRoute, opts ...grpc.CallOption) (*RouteInfo, error) {
+	out := new(RouteInfo)
+	err := c.cc.Invoke(ctx, "/delivery.DeliveryRoute/CreateRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryRouteClient) UpdateRoute(ctx context.Context, in *ReqRoute, opts ...grpc.CallOption) (*RouteInfo, error) {
+	out := new(RouteInfo)
+	err := c.cc.Invoke(ctx, "/delivery.DeliveryRoute/UpdateRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryRouteClient) GetRouteByOrderId(ctx context.Context, in *Id, opts ...grpc.CallOption) (*RouteInfo, error) {
+	out := new(RouteInfo)
+	err := c.cc.Invoke(ctx, "/delivery.DeliveryRoute/GetRouteByOrderId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeliveryRouteServer is the server API for DeliveryRoute service.
+// All implementations must embed UnimplementedDeliveryRouteServer
+// for forward compatibility
+type DeliveryRouteServer interface {
+	CreateRoute(context.Context, *ReqRoute) (*RouteInfo, error)
+	UpdateRoute(context.Context, *ReqRoute) (*RouteInfo, error)
+	GetRouteByOrderId(context.Context, *Id) (*RouteInfo, error)
+	mustEmbedUnimplementedDeliveryRouteServer()
+}
+
+// UnimplementedDeliveryRouteServer must be embedded to have forward compatible implementations.
+type UnimplementedDeliveryRouteServer struct {
+}
+
+func (UnimplementedDeliveryRouteServer) CreateRoute(context.Context, *ReqRoute) (*RouteInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoute not implemented")
+}
+func (UnimplementedDeliveryRouteServer) UpdateRoute(context.Context, *ReqRoute) (*RouteInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoute not implemented")
+}
+func (UnimplementedDeliveryRouteServer) GetRouteByOrderId(context.Context, *Id) (*RouteInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRouteByOrderId not implemented")
+}
+func (UnimplementedDeliveryRouteServer) mustEmbedUnimplementedDeliveryRouteServer() {}
+
+// UnsafeDeliveryRouteServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeliveryRouteServer will
+// result in compilation errors.
+type UnsafeDeliveryRouteServer interface {
+	mustEmbedUnimplementedDeliveryRouteServer()
+}
+
+func RegisterDeliveryRouteServer(s grpc.ServiceRegistrar, srv DeliveryRouteServer) {
+	s.RegisterService(&DeliveryRoute_ServiceDesc, srv)
+}
+
+func _DeliveryRoute_CreateRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryRouteServer).CreateRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/delivery.DeliveryRoute/CreateRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryRouteServer).CreateRoute(ctx, req.(*ReqRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryRoute_UpdateRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryRouteServer).UpdateRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/delivery.DeliveryRoute/UpdateRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryRouteServer).UpdateRoute(ctx, req.(*ReqRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryRoute_GetRouteByOrderId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryRouteServer).GetRouteByOrderId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/delivery.DeliveryRoute/GetRouteByOrderId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryRouteServer).GetRouteByOrderId(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeliveryRoute_ServiceDesc is the grpc.ServiceDesc for DeliveryRoute service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeliveryRoute_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "delivery.DeliveryRoute",
+	HandlerType: (*DeliveryRouteServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRoute",
+			Handler:    _DeliveryRoute_CreateRoute_Handler,
+		},
+		{
+			MethodName: "UpdateRoute",
+			Handler:    _DeliveryRoute_UpdateRoute_Handler,
+		},
+		{
+			MethodName: "GetRouteByOrderId",
+			Handler:    _DeliveryRoute_GetRouteByOrderId_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "delivery.proto",
+}
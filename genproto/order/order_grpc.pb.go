@@ -28,10 +28,19 @@ type OrderClient interface {
 	GetOrdersForUser(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*Orders, error)
 	GetOrdersForChef(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*Orders, error)
 	DeleteOrder(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Void, error)
+	CancelOrder(ctx context.Context, in *ReqCancelOrder, opts ...grpc.CallOption) (*CancelOrderRes, error)
+	WatchOrder(ctx context.Context, in *Id, opts ...grpc.CallOption) (Order_WatchOrderClient, error)
+	WatchKitchenOrders(ctx context.Context, in *Id, opts ...grpc.CallOption) (Order_WatchKitchenOrdersClient, error)
 	ValidateOrderId(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Void, error)
 	GetKitchenStatistics(ctx context.Context, in *DateFilter, opts ...grpc.CallOption) (*KitchenStatistics, error)
 	GetUserStatistics(ctx context.Context, in *DateFilter, opts ...grpc.CallOption) (*UserStatistics, error)
 	ManageWorkingHours(ctx context.Context, in *Id, opts ...grpc.CallOption) (*WorkingHours, error)
+	CreateWorkingHours(ctx context.Context, in *WorkingHours, opts ...grpc.CallOption) (*WorkingHoursRes, error)
+	UpdateWorkingHours(ctx context.Context, in *WorkingHours, opts ...grpc.CallOption) (*WorkingHoursRes, error)
+	DeleteWorkingHours(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Void, error)
+	CreateWorkingHoursOverride(ctx context.Context, in *WorkingHoursOverride, opts ...grpc.CallOption) (*WorkingHoursOverride, error)
+	GetWorkingHoursOverrides(ctx context.Context, in *Id, opts ...grpc.CallOption) (*WorkingHoursOverrides, error)
+	DeleteWorkingHoursOverride(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Void, error)
 }
 
 type orderClient struct {
@@ -96,6 +105,79 @@ func (c *orderClient) DeleteOrder(ctx context.Context, in *Id, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *orderClient) CancelOrder(ctx context.Context, in *ReqCancelOrder, opts ...grpc.CallOption) (*CancelOrderRes, error) {
+	out := new(CancelOrderRes)
+	err := c.cc.Invoke(ctx, "/order.Order/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) WatchOrder(ctx context.Context, in *Id, opts ...grpc.CallOption) (Order_WatchOrderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Order_ServiceDesc.Streams[0], "/order.Order/WatchOrder", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderWatchOrderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Order_WatchOrderClient interface {
+	Recv() (*OrderEvent, error)
+	grpc.ClientStream
+}
+
+type orderWatchOrderClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderWatchOrderClient) Recv() (*OrderEvent, error) {
+	m := new(OrderEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orderClient) WatchKitchenOrders(ctx context.Context, in *Id, opts ...grpc.CallOption) (Order_WatchKitchenOrdersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Order_ServiceDesc.Streams[1], "/order.Order/WatchKitchenOrders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderWatchKitchenOrdersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Order_WatchKitchenOrdersClient interface {
+	Recv() (*OrderEvent, error)
+	grpc.ClientStream
+}
+
+type orderWatchKitchenOrdersClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderWatchKitchenOrdersClient) Recv() (*OrderEvent, error) {
+	m := new(OrderEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *orderClient) ValidateOrderId(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Void, error) {
 	out := new(Void)
 	err := c.cc.Invoke(ctx, "/order.Order/ValidateOrderId", in, out, opts...)
@@ -132,6 +214,60 @@ func (c *orderClient) ManageWorkingHours(ctx context.Context, in *Id, opts ...gr
 	return out, nil
 }
 
+func (c *orderClient) CreateWorkingHours(ctx context.Context, in *WorkingHours, opts ...grpc.CallOption) (*WorkingHoursRes, error) {
+	out := new(WorkingHoursRes)
+	err := c.cc.Invoke(ctx, "/order.Order/CreateWorkingHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) UpdateWorkingHours(ctx context.Context, in *WorkingHours, opts ...grpc.CallOption) (*WorkingHoursRes, error) {
+	out := new(WorkingHoursRes)
+	err := c.cc.Invoke(ctx, "/order.Order/UpdateWorkingHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) DeleteWorkingHours(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/order.Order/DeleteWorkingHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CreateWorkingHoursOverride(ctx context.Context, in *WorkingHoursOverride, opts ...grpc.CallOption) (*WorkingHoursOverride, error) {
+	out := new(WorkingHoursOverride)
+	err := c.cc.Invoke(ctx, "/order.Order/CreateWorkingHoursOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetWorkingHoursOverrides(ctx context.Context, in *Id, opts ...grpc.CallOption) (*WorkingHoursOverrides, error) {
+	out := new(WorkingHoursOverrides)
+	err := c.cc.Invoke(ctx, "/order.Order/GetWorkingHoursOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) DeleteWorkingHoursOverride(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/order.Order/DeleteWorkingHoursOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServer is the server API for Order service.
 // All implementations must embed UnimplementedOrderServer
 // for forward compatibility
@@ -142,10 +278,19 @@ type OrderServer interface {
 	GetOrdersForUser(context.Context, *Filter) (*Orders, error)
 	GetOrdersForChef(context.Context, *Filter) (*Orders, error)
 	DeleteOrder(context.Context, *Id) (*Void, error)
+	CancelOrder(context.Context, *ReqCancelOrder) (*CancelOrderRes, error)
+	WatchOrder(*Id, Order_WatchOrderServer) error
+	WatchKitchenOrders(*Id, Order_WatchKitchenOrdersServer) error
 	ValidateOrderId(context.Context, *Id) (*Void, error)
 	GetKitchenStatistics(context.Context, *DateFilter) (*KitchenStatistics, error)
 	GetUserStatistics(context.Context, *DateFilter) (*UserStatistics, error)
 	ManageWorkingHours(context.Context, *Id) (*WorkingHours, error)
+	CreateWorkingHours(context.Context, *WorkingHours) (*WorkingHoursRes, error)
+	UpdateWorkingHours(context.Context, *WorkingHours) (*WorkingHoursRes, error)
+	DeleteWorkingHours(context.Context, *Id) (*Void, error)
+	CreateWorkingHoursOverride(context.Context, *WorkingHoursOverride) (*WorkingHoursOverride, error)
+	GetWorkingHoursOverrides(context.Context, *Id) (*WorkingHoursOverrides, error)
+	DeleteWorkingHoursOverride(context.Context, *Id) (*Void, error)
 	mustEmbedUnimplementedOrderServer()
 }
 
@@ -171,6 +316,15 @@ func (UnimplementedOrderServer) GetOrdersForChef(context.Context, *Filter) (*Ord
 func (UnimplementedOrderServer) DeleteOrder(context.Context, *Id) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrder not implemented")
 }
+func (UnimplementedOrderServer) CancelOrder(context.Context, *ReqCancelOrder) (*CancelOrderRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedOrderServer) WatchOrder(*Id, Order_WatchOrderServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
+func (UnimplementedOrderServer) WatchKitchenOrders(*Id, Order_WatchKitchenOrdersServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchKitchenOrders not implemented")
+}
 func (UnimplementedOrderServer) ValidateOrderId(context.Context, *Id) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateOrderId not implemented")
 }
@@ -183,6 +337,24 @@ func (UnimplementedOrderServer) GetUserStatistics(context.Context, *DateFilter)
 func (UnimplementedOrderServer) ManageWorkingHours(context.Context, *Id) (*WorkingHours, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ManageWorkingHours not implemented")
 }
+func (UnimplementedOrderServer) CreateWorkingHours(context.Context, *WorkingHours) (*WorkingHoursRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkingHours not implemented")
+}
+func (UnimplementedOrderServer) UpdateWorkingHours(context.Context, *WorkingHours) (*WorkingHoursRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkingHours not implemented")
+}
+func (UnimplementedOrderServer) DeleteWorkingHours(context.Context, *Id) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkingHours not implemented")
+}
+func (UnimplementedOrderServer) CreateWorkingHoursOverride(context.Context, *WorkingHoursOverride) (*WorkingHoursOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkingHoursOverride not implemented")
+}
+func (UnimplementedOrderServer) GetWorkingHoursOverrides(context.Context, *Id) (*WorkingHoursOverrides, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkingHoursOverrides not implemented")
+}
+func (UnimplementedOrderServer) DeleteWorkingHoursOverride(context.Context, *Id) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkingHoursOverride not implemented")
+}
 func (UnimplementedOrderServer) mustEmbedUnimplementedOrderServer() {}
 
 // UnsafeOrderServer may be embedded to opt out of forward compatibility for this service.
@@ -304,6 +476,66 @@ func _Order_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqCancelOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.Order/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CancelOrder(ctx, req.(*ReqCancelOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Id)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServer).WatchOrder(m, &orderWatchOrderServer{stream})
+}
+
+type Order_WatchOrderServer interface {
+	Send(*OrderEvent) error
+	grpc.ServerStream
+}
+
+type orderWatchOrderServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderWatchOrderServer) Send(m *OrderEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Order_WatchKitchenOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Id)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServer).WatchKitchenOrders(m, &orderWatchKitchenOrdersServer{stream})
+}
+
+type Order_WatchKitchenOrdersServer interface {
+	Send(*OrderEvent) error
+	grpc.ServerStream
+}
+
+type orderWatchKitchenOrdersServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderWatchKitchenOrdersServer) Send(m *OrderEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Order_ValidateOrderId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Id)
 	if err := dec(in); err != nil {
@@ -376,6 +608,114 @@ func _Order_ManageWorkingHours_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_CreateWorkingHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkingHours)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreateWorkingHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.Order/CreateWorkingHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreateWorkingHours(ctx, req.(*WorkingHours))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_UpdateWorkingHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkingHours)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).UpdateWorkingHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.Order/UpdateWorkingHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).UpdateWorkingHours(ctx, req.(*WorkingHours))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_DeleteWorkingHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).DeleteWorkingHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.Order/DeleteWorkingHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).DeleteWorkingHours(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CreateWorkingHoursOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkingHoursOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreateWorkingHoursOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.Order/CreateWorkingHoursOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreateWorkingHoursOverride(ctx, req.(*WorkingHoursOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetWorkingHoursOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetWorkingHoursOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.Order/GetWorkingHoursOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetWorkingHoursOverrides(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_DeleteWorkingHoursOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).DeleteWorkingHoursOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.Order/DeleteWorkingHoursOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).DeleteWorkingHoursOverride(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Order_ServiceDesc is the grpc.ServiceDesc for Order service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -407,6 +747,10 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOrder",
 			Handler:    _Order_DeleteOrder_Handler,
 		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Order_CancelOrder_Handler,
+		},
 		{
 			MethodName: "ValidateOrderId",
 			Handler:    _Order_ValidateOrderId_Handler,
@@ -423,7 +767,42 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ManageWorkingHours",
 			Handler:    _Order_ManageWorkingHours_Handler,
 		},
+		{
+			MethodName: "CreateWorkingHours",
+			Handler:    _Order_CreateWorkingHours_Handler,
+		},
+		{
+			MethodName: "UpdateWorkingHours",
+			Handler:    _Order_UpdateWorkingHours_Handler,
+		},
+		{
+			MethodName: "DeleteWorkingHours",
+			Handler:    _Order_DeleteWorkingHours_Handler,
+		},
+		{
+			MethodName: "CreateWorkingHoursOverride",
+			Handler:    _Order_CreateWorkingHoursOverride_Handler,
+		},
+		{
+			MethodName: "GetWorkingHoursOverrides",
+			Handler:    _Order_GetWorkingHoursOverrides_Handler,
+		},
+		{
+			MethodName: "DeleteWorkingHoursOverride",
+			Handler:    _Order_DeleteWorkingHoursOverride_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _Order_WatchOrder_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchKitchenOrders",
+			Handler:       _Order_WatchKitchenOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
 }
@@ -24,6 +24,7 @@ const _ = grpc.SupportPackageIsVersion7
 type PaymentClient interface {
 	CreatePayment(ctx context.Context, in *ReqCreatePayment, opts ...grpc.CallOption) (*PaymentInfo, error)
 	ValidatePaymentId(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Void, error)
+	RefundPayment(ctx context.Context, in *ReqRefundPayment, opts ...grpc.CallOption) (*RefundInfo, error)
 }
 
 type paymentClient struct {
@@ -52,12 +53,22 @@ func (c *paymentClient) ValidatePaymentId(ctx context.Context, in *Id, opts ...g
 	return out, nil
 }
 
+func (c *paymentClient) RefundPayment(ctx context.Context, in *ReqRefundPayment, opts ...grpc.CallOption) (*RefundInfo, error) {
+	out := new(RefundInfo)
+	err := c.cc.Invoke(ctx, "/payment.Payment/RefundPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServer is the server API for Payment service.
 // All implementations must embed UnimplementedPaymentServer
 // for forward compatibility
 type PaymentServer interface {
 	CreatePayment(context.Context, *ReqCreatePayment) (*PaymentInfo, error)
 	ValidatePaymentId(context.Context, *Id) (*Void, error)
+	RefundPayment(context.Context, *ReqRefundPayment) (*RefundInfo, error)
 	mustEmbedUnimplementedPaymentServer()
 }
 
@@ -71,6 +82,9 @@ func (UnimplementedPaymentServer) CreatePayment(context.Context, *ReqCreatePayme
 func (UnimplementedPaymentServer) ValidatePaymentId(context.Context, *Id) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatePaymentId not implemented")
 }
+func (UnimplementedPaymentServer) RefundPayment(context.Context, *ReqRefundPayment) (*RefundInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundPayment not implemented")
+}
 func (UnimplementedPaymentServer) mustEmbedUnimplementedPaymentServer() {}
 
 // UnsafePaymentServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +134,24 @@ func _Payment_ValidatePaymentId_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Payment_RefundPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqRefundPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).RefundPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/payment.Payment/RefundPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).RefundPayment(ctx, req.(*ReqRefundPayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Payment_ServiceDesc is the grpc.ServiceDesc for Payment service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +167,10 @@ var Payment_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatePaymentId",
 			Handler:    _Payment_ValidatePaymentId_Handler,
 		},
+		{
+			MethodName: "RefundPayment",
+			Handler:    _Payment_RefundPayment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment.proto",
@@ -26,6 +26,11 @@ type ReviewClient interface {
 	GetReviewsByKitchenId(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*Reviews, error)
 	DeleteComment(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Void, error)
 	ValidateReviewId(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Void, error)
+	UpdateReview(ctx context.Context, in *ReqUpdateReview, opts ...grpc.CallOption) (*ReviewInfo, error)
+	ReplyToReview(ctx context.Context, in *ReqReplyToReview, opts ...grpc.CallOption) (*ReviewReply, error)
+	FlagReview(ctx context.Context, in *ReqFlagReview, opts ...grpc.CallOption) (*Void, error)
+	ModerateReview(ctx context.Context, in *ReqModerateReview, opts ...grpc.CallOption) (*ReviewInfo, error)
+	GetRatingStats(ctx context.Context, in *Id, opts ...grpc.CallOption) (*RatingStats, error)
 }
 
 type reviewClient struct {
@@ -72,6 +77,51 @@ func (c *reviewClient) ValidateReviewId(ctx context.Context, in *Id, opts ...grp
 	return out, nil
 }
 
+func (c *reviewClient) UpdateReview(ctx context.Context, in *ReqUpdateReview, opts ...grpc.CallOption) (*ReviewInfo, error) {
+	out := new(ReviewInfo)
+	err := c.cc.Invoke(ctx, "/review.Review/UpdateReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ReplyToReview(ctx context.Context, in *ReqReplyToReview, opts ...grpc.CallOption) (*ReviewReply, error) {
+	out := new(ReviewReply)
+	err := c.cc.Invoke(ctx, "/review.Review/ReplyToReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) FlagReview(ctx context.Context, in *ReqFlagReview, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/review.Review/FlagReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ModerateReview(ctx context.Context, in *ReqModerateReview, opts ...grpc.CallOption) (*ReviewInfo, error) {
+	out := new(ReviewInfo)
+	err := c.cc.Invoke(ctx, "/review.Review/ModerateReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) GetRatingStats(ctx context.Context, in *Id, opts ...grpc.CallOption) (*RatingStats, error) {
+	out := new(RatingStats)
+	err := c.cc.Invoke(ctx, "/review.Review/GetRatingStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReviewServer is the server API for Review service.
 // All implementations must embed UnimplementedReviewServer
 // for forward compatibility
@@ -80,6 +130,11 @@ type ReviewServer interface {
 	GetReviewsByKitchenId(context.Context, *Filter) (*Reviews, error)
 	DeleteComment(context.Context, *Id) (*Void, error)
 	ValidateReviewId(context.Context, *Id) (*Void, error)
+	UpdateReview(context.Context, *ReqUpdateReview) (*ReviewInfo, error)
+	ReplyToReview(context.Context, *ReqReplyToReview) (*ReviewReply, error)
+	FlagReview(context.Context, *ReqFlagReview) (*Void, error)
+	ModerateReview(context.Context, *ReqModerateReview) (*ReviewInfo, error)
+	GetRatingStats(context.Context, *Id) (*RatingStats, error)
 	mustEmbedUnimplementedReviewServer()
 }
 
@@ -99,6 +154,21 @@ func (UnimplementedReviewServer) DeleteComment(context.Context, *Id) (*Void, err
 func (UnimplementedReviewServer) ValidateReviewId(context.Context, *Id) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateReviewId not implemented")
 }
+func (UnimplementedReviewServer) UpdateReview(context.Context, *ReqUpdateReview) (*ReviewInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedReviewServer) ReplyToReview(context.Context, *ReqReplyToReview) (*ReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToReview not implemented")
+}
+func (UnimplementedReviewServer) FlagReview(context.Context, *ReqFlagReview) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlagReview not implemented")
+}
+func (UnimplementedReviewServer) ModerateReview(context.Context, *ReqModerateReview) (*ReviewInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
+}
+func (UnimplementedReviewServer) GetRatingStats(context.Context, *Id) (*RatingStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatingStats not implemented")
+}
 func (UnimplementedReviewServer) mustEmbedUnimplementedReviewServer() {}
 
 // UnsafeReviewServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +254,96 @@ func _Review_ValidateReviewId_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Review_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUpdateReview)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/review.Review/UpdateReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).UpdateReview(ctx, req.(*ReqUpdateReview))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ReplyToReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqReplyToReview)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ReplyToReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/review.Review/ReplyToReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ReplyToReview(ctx, req.(*ReqReplyToReview))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_FlagReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqFlagReview)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).FlagReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/review.Review/FlagReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).FlagReview(ctx, req.(*ReqFlagReview))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqModerateReview)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ModerateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/review.Review/ModerateReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ModerateReview(ctx, req.(*ReqModerateReview))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_GetRatingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetRatingStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/review.Review/GetRatingStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetRatingStats(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Review_ServiceDesc is the grpc.ServiceDesc for Review service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +367,26 @@ var Review_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateReviewId",
 			Handler:    _Review_ValidateReviewId_Handler,
 		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _Review_UpdateReview_Handler,
+		},
+		{
+			MethodName: "ReplyToReview",
+			Handler:    _Review_ReplyToReview_Handler,
+		},
+		{
+			MethodName: "FlagReview",
+			Handler:    _Review_FlagReview_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _Review_ModerateReview_Handler,
+		},
+		{
+			MethodName: "GetRatingStats",
+			Handler:    _Review_GetRatingStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "review.proto",
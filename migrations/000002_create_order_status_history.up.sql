@@ -0,0 +1,10 @@
+CREATE TABLE order_status_history (
+    id UUID PRIMARY KEY,
+    order_id UUID REFERENCES orders(id),
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    actor_id UUID,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX order_status_history_order_id_idx ON order_status_history (order_id, created_at);
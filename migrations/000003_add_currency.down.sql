@@ -0,0 +1,3 @@
+ALTER TABLE payments DROP COLUMN IF EXISTS currency;
+ALTER TABLE orders DROP COLUMN IF EXISTS currency;
+ALTER TABLE dishes DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,5 @@
+-- Rows stored before this migration are assumed to be in UZS, the default
+-- CURRENCY of the service.
+ALTER TABLE dishes ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'UZS';
+ALTER TABLE orders ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'UZS';
+ALTER TABLE payments ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'UZS';
@@ -0,0 +1,12 @@
+CREATE TABLE working_hours_overrides (
+    id UUID PRIMARY KEY,
+    kitchen_id UUID NOT NULL,
+    date DATE NOT NULL,
+    is_closed BOOLEAN NOT NULL DEFAULT true,
+    open_time TIME,
+    close_time TIME,
+    repeats_yearly BOOLEAN NOT NULL DEFAULT false,
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (kitchen_id, date)
+);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS delivery_routes_order_id_idx;
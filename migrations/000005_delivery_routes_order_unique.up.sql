@@ -0,0 +1 @@
+CREATE UNIQUE INDEX delivery_routes_order_id_idx ON delivery_routes (order_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE payments ADD COLUMN card_number VARCHAR(16);
+
+ALTER TABLE payments DROP COLUMN IF EXISTS card_last4;
+ALTER TABLE payments DROP COLUMN IF EXISTS card_brand;
+ALTER TABLE payments DROP COLUMN IF EXISTS card_token;
@@ -0,0 +1,7 @@
+ALTER TABLE payments ADD COLUMN card_token VARCHAR(64);
+ALTER TABLE payments ADD COLUMN card_brand VARCHAR(20);
+ALTER TABLE payments ADD COLUMN card_last4 CHAR(4);
+
+UPDATE payments SET card_last4 = right(card_number, 4) WHERE card_number IS NOT NULL AND card_number <> '';
+
+ALTER TABLE payments DROP COLUMN card_number;
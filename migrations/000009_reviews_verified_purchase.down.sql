@@ -0,0 +1,3 @@
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_rating_check;
+
+DROP INDEX IF EXISTS reviews_order_id_idx;
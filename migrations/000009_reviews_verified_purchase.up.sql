@@ -0,0 +1,10 @@
+UPDATE reviews r
+SET deleted_at = now()
+WHERE r.deleted_at IS NULL AND EXISTS (
+    SELECT 1 FROM reviews o
+    WHERE o.order_id = r.order_id AND o.deleted_at IS NULL AND (o.created_at, o.id) < (r.created_at, r.id)
+);
+
+CREATE UNIQUE INDEX reviews_order_id_idx ON reviews (order_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE reviews ADD CONSTRAINT reviews_rating_check CHECK (rating BETWEEN 1 AND 5) NOT VALID;
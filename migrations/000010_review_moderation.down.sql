@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS review_replies;
+DROP TABLE IF EXISTS review_edits;
+
+ALTER TABLE reviews DROP COLUMN IF EXISTS moderation_reason;
+ALTER TABLE reviews DROP COLUMN IF EXISTS moderation_status;
@@ -0,0 +1,21 @@
+ALTER TABLE reviews ADD COLUMN moderation_status VARCHAR(20) NOT NULL DEFAULT 'visible';
+ALTER TABLE reviews ADD COLUMN moderation_reason TEXT;
+
+CREATE TABLE review_edits (
+    id UUID PRIMARY KEY,
+    review_id UUID NOT NULL REFERENCES reviews(id),
+    rating SMALLINT NOT NULL,
+    comment TEXT,
+    edited_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX review_edits_review_id_idx ON review_edits (review_id, edited_at);
+
+CREATE TABLE review_replies (
+    id UUID PRIMARY KEY,
+    review_id UUID NOT NULL UNIQUE REFERENCES reviews(id),
+    chef_id UUID NOT NULL,
+    comment TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS orders_kitchen_id_created_at_idx;
+DROP INDEX IF EXISTS orders_user_id_created_at_idx;
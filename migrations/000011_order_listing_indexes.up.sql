@@ -0,0 +1,2 @@
+CREATE INDEX orders_user_id_created_at_idx ON orders (user_id, created_at DESC, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX orders_kitchen_id_created_at_idx ON orders (kitchen_id, created_at DESC, id DESC) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS cancellation_fee;
+ALTER TABLE orders DROP COLUMN IF EXISTS cancel_comment;
+ALTER TABLE orders DROP COLUMN IF EXISTS cancel_reason;
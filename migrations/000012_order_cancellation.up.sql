@@ -0,0 +1,3 @@
+ALTER TABLE orders ADD COLUMN cancel_reason VARCHAR(50);
+ALTER TABLE orders ADD COLUMN cancel_comment TEXT;
+ALTER TABLE orders ADD COLUMN cancellation_fee DECIMAL(10, 2);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS orders_scheduled_delivery_time_idx;
+DROP INDEX IF EXISTS orders_kitchen_id_delivery_time_idx;
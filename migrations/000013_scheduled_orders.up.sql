@@ -0,0 +1,2 @@
+CREATE INDEX orders_kitchen_id_delivery_time_idx ON orders (kitchen_id, delivery_time) WHERE deleted_at IS NULL;
+CREATE INDEX orders_scheduled_delivery_time_idx ON orders (delivery_time) WHERE status = 'scheduled' AND deleted_at IS NULL;
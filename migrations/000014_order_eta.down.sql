@@ -0,0 +1,2 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS estimated_delivery_time;
+ALTER TABLE dishes DROP COLUMN IF EXISTS prep_time_minutes;
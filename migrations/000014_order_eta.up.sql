@@ -0,0 +1,2 @@
+ALTER TABLE dishes ADD COLUMN prep_time_minutes INTEGER NOT NULL DEFAULT 15 CHECK (prep_time_minutes > 0);
+ALTER TABLE orders ADD COLUMN estimated_delivery_time TIMESTAMP WITH TIME ZONE;
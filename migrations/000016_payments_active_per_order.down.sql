@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS payments_order_id_active_idx;
+
+ALTER TABLE payments DROP COLUMN IF EXISTS duplicate_of;
@@ -0,0 +1,27 @@
+ALTER TABLE payments ADD COLUMN duplicate_of UUID REFERENCES payments(id);
+
+-- Keep one active payment per order, captured ones first. Duplicate captures
+-- stay captured so that admins can refund them; the others are voided.
+WITH ranked AS (
+    SELECT
+        id,
+        status,
+        first_value(id) OVER w AS kept,
+        row_number() OVER w AS n
+    FROM payments
+    WHERE status IN ('pending', 'authorized', 'captured', 'partially_refunded')
+    WINDOW w AS (
+        PARTITION BY order_id
+        ORDER BY status IN ('captured', 'partially_refunded') DESC, status = 'authorized' DESC, created_at, id
+    )
+)
+UPDATE payments p
+SET duplicate_of = r.kept,
+    status = CASE WHEN r.status IN ('pending', 'authorized') THEN 'voided' ELSE r.status END,
+    failure_reason = CASE WHEN r.status IN ('pending', 'authorized') THEN 'duplicate payment' ELSE p.failure_reason END,
+    updated_at = now()
+FROM ranked r
+WHERE p.id = r.id AND r.n > 1;
+
+CREATE UNIQUE INDEX payments_order_id_active_idx ON payments (order_id)
+    WHERE duplicate_of IS NULL AND status IN ('pending', 'authorized', 'captured', 'partially_refunded');
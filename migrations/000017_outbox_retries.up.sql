@@ -0,0 +1,5 @@
+ALTER TABLE outbox ADD COLUMN next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE outbox ADD COLUMN dead_at TIMESTAMP WITH TIME ZONE;
+
+DROP INDEX IF EXISTS outbox_unpublished_idx;
+CREATE INDEX outbox_due_idx ON outbox (next_attempt_at) WHERE published_at IS NULL AND dead_at IS NULL;
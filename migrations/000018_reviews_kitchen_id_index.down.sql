@@ -0,0 +1 @@
+DROP INDEX IF EXISTS reviews_kitchen_id_created_at_idx;
@@ -0,0 +1 @@
+CREATE INDEX reviews_kitchen_id_created_at_idx ON reviews (kitchen_id, created_at) WHERE deleted_at IS NULL;
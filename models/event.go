@@ -0,0 +1,55 @@
+package models
+
+// Domain events published through the outbox.
+const (
+	EventOrderCreated       = "OrderCreated"
+	EventOrderStatusChanged = "OrderStatusChanged"
+	EventPaymentCaptured    = "PaymentCaptured"
+	EventReviewPosted       = "ReviewPosted"
+)
+
+// Aggregates the domain events belong to.
+const (
+	AggregateOrder   = "order"
+	AggregatePayment = "payment"
+	AggregateReview  = "review"
+)
+
+// OrderCreatedEvent is the payload of EventOrderCreated. Amounts are in minor
+// units.
+type OrderCreatedEvent struct {
+	OrderId      string `json:"order_id"`
+	UserId       string `json:"user_id"`
+	KitchenId    string `json:"kitchen_id"`
+	Status       string `json:"status"`
+	TotalAmount  int64  `json:"total_amount"`
+	Currency     string `json:"currency"`
+	DeliveryTime string `json:"delivery_time,omitempty"`
+}
+
+// OrderStatusChangedEvent is the payload of EventOrderStatusChanged.
+type OrderStatusChangedEvent struct {
+	OrderId    string `json:"order_id"`
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	ActorId    string `json:"actor_id,omitempty"`
+}
+
+// PaymentCapturedEvent is the payload of EventPaymentCaptured. Amounts are in
+// minor units.
+type PaymentCapturedEvent struct {
+	PaymentId     string `json:"payment_id"`
+	OrderId       string `json:"order_id"`
+	Amount        int64  `json:"amount"`
+	Currency      string `json:"currency"`
+	TransactionId string `json:"transaction_id"`
+}
+
+// ReviewPostedEvent is the payload of EventReviewPosted.
+type ReviewPostedEvent struct {
+	ReviewId  string `json:"review_id"`
+	OrderId   string `json:"order_id"`
+	UserId    string `json:"user_id"`
+	KitchenId string `json:"kitchen_id"`
+	Rating    int32  `json:"rating"`
+}
@@ -1,6 +1,54 @@
 package models
 
+import (
+	"order_service/pkg/money"
+	"time"
+)
+
 type RevenueStats struct{
 	TotalOrders int
-	Revenue float64
-}
\ No newline at end of file
+	Revenue money.Money
+}
+
+// HourBucket holds the orders a kitchen received in one hour of one day of the
+// week, in the time zone of the kitchen.
+type HourBucket struct {
+	Weekday time.Weekday
+	Hour    int
+	Orders  int
+	Revenue money.Money
+}
+
+const (
+	OrderSortNewest  = "newest"
+	OrderSortOldest  = "oldest"
+	OrderSortHighest = "highest_total"
+	OrderSortLowest  = "lowest_total"
+)
+
+// Reasons an order can be cancelled for.
+const (
+	CancelReasonChangedMind        = "changed_mind"
+	CancelReasonOrderedByMistake   = "ordered_by_mistake"
+	CancelReasonTooSlow            = "too_slow"
+	CancelReasonKitchenUnavailable = "kitchen_unavailable"
+	CancelReasonOutOfStock         = "out_of_stock"
+	CancelReasonOther              = "other"
+)
+
+// ScheduledOrder is a scheduled order due for release to the kitchen. Paid
+// tells whether a payment for it was captured already.
+type ScheduledOrder struct {
+	Id        string
+	KitchenId string
+	UserId    string
+	Paid      bool
+}
+
+// Kinds of order events streamed to watchers.
+const (
+	OrderEventSnapshot      = "snapshot"
+	OrderEventCreated       = "created"
+	OrderEventStatusChanged = "status_changed"
+	OrderEventEtaUpdated    = "eta_updated"
+)
@@ -0,0 +1,25 @@
+package models
+
+const (
+	PaymentPending    = "pending"
+	PaymentAuthorized = "authorized"
+	PaymentCaptured   = "captured"
+	PaymentFailed     = "failed"
+	PaymentVoided     = "voided"
+
+	PaymentPartiallyRefunded = "partially_refunded"
+	PaymentRefunded          = "refunded"
+)
+
+const (
+	RefundPending   = "pending"
+	RefundSucceeded = "succeeded"
+	RefundFailed    = "failed"
+)
+
+const (
+	PaymentMethodCreditCard = "credit_card"
+	PaymentMethodDebitCard  = "debit_card"
+	PaymentMethodCash       = "cash"
+	PaymentMethodPayLater   = "pay_later"
+)
@@ -3,4 +3,22 @@ package models
 type ReviewsStats struct {
 	TotalNumberOfComments int
 	AvarageRating         float32
+	// Histogram[i] counts the reviews with i+1 stars.
+	Histogram      [5]int64
+	Average30Days  float32
+	Average90Days  float32
+	BayesianRating float32
 }
+
+const (
+	ReviewVisible = "visible"
+	ReviewFlagged = "flagged"
+	ReviewHidden  = "hidden"
+)
+
+const (
+	ReviewSortNewest     = "newest"
+	ReviewSortHighest    = "highest"
+	ReviewSortLowest     = "lowest"
+	ReviewSortHasComment = "has_comment"
+)
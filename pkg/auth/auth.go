@@ -0,0 +1,31 @@
+package auth
+
+import "context"
+
+const (
+	RoleCustomer = "customer"
+	RoleChef     = "chef"
+	RoleAdmin    = "admin"
+)
+
+// Principal is the authenticated caller of an RPC.
+type Principal struct {
+	UserId string
+	Role   string
+}
+
+func (p *Principal) IsAdmin() bool {
+	return p.Role == RoleAdmin
+}
+
+type principalKey struct{}
+
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal the interceptor authenticated, if any.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
@@ -0,0 +1,189 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const secret = "test-secret"
+
+func token(t *testing.T, claims Claims) string {
+	t.Helper()
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsigned := header + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(NewJWTVerifier(secret).sign(unsigned))
+}
+
+func TestVerify(t *testing.T) {
+	v := NewJWTVerifier(secret)
+	exp := time.Now().Add(time.Hour).Unix()
+
+	p, err := v.Verify(token(t, Claims{Id: "u1", UserType: RoleChef, Expires: exp}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.UserId != "u1" || p.Role != RoleChef {
+		t.Errorf("unexpected principal %+v", p)
+	}
+
+	if _, err := v.Verify(token(t, Claims{Id: "u1", UserType: RoleChef, Expires: 1})); err == nil {
+		t.Error("expected an expired token to be rejected")
+	}
+	if _, err := v.Verify(token(t, Claims{Id: "u1", UserType: RoleChef})); err == nil {
+		t.Error("expected a token without expiry to be rejected")
+	}
+	if _, err := NewJWTVerifier("other").Verify(token(t, Claims{Id: "u1", UserType: RoleChef, Expires: exp})); err == nil {
+		t.Error("expected a token signed with another secret to be rejected")
+	}
+	if _, err := v.Verify("not.a.token"); err == nil {
+		t.Error("expected a malformed token to be rejected")
+	}
+}
+
+type request struct {
+	UserId    string
+	KitchenId string
+}
+
+func TestInterceptor(t *testing.T) {
+	rules := map[string]Rule{
+		"/test/Public": {Public: true},
+		"/test/CreateOrder": {
+			Roles: []string{RoleCustomer},
+			Self:  func(req any) string { return req.(*request).UserId },
+		},
+		"/test/CreateDish": {
+			Roles:   []string{RoleChef},
+			Kitchen: func(req any) string { return req.(*request).KitchenId },
+		},
+	}
+	owner := func(ctx context.Context, kitchenId string) (string, error) {
+		return map[string]string{"k1": "chef1"}[kitchenId], nil
+	}
+	interceptor := UnaryServerInterceptor(NewJWTVerifier(secret), rules, owner)
+
+	call := func(method string, claims *Claims, req *request) codes.Code {
+		ctx := context.Background()
+		if claims != nil {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token(t, *claims)))
+		}
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req any) (any, error) {
+				if _, ok := FromContext(ctx); !ok && method != "/test/Public" {
+					t.Errorf("%s: principal missing from handler context", method)
+				}
+				return nil, nil
+			})
+		return status.Code(err)
+	}
+
+	exp := time.Now().Add(time.Hour).Unix()
+	customer := &Claims{Id: "user1", UserType: RoleCustomer, Expires: exp}
+	chef := &Claims{Id: "chef1", UserType: RoleChef, Expires: exp}
+	admin := &Claims{Id: "admin1", UserType: RoleAdmin, Expires: exp}
+
+	cases := []struct {
+		name   string
+		method string
+		claims *Claims
+		req    *request
+		want   codes.Code
+	}{
+		{"public without token", "/test/Public", nil, &request{}, codes.OK},
+		{"missing token", "/test/CreateOrder", nil, &request{UserId: "user1"}, codes.Unauthenticated},
+		{"unknown method", "/test/Unknown", customer, &request{}, codes.PermissionDenied},
+		{"customer for self", "/test/CreateOrder", customer, &request{UserId: "user1"}, codes.OK},
+		{"customer for another user", "/test/CreateOrder", customer, &request{UserId: "user2"}, codes.PermissionDenied},
+		{"chef calling customer rpc", "/test/CreateOrder", chef, &request{UserId: "chef1"}, codes.PermissionDenied},
+		{"chef of the kitchen", "/test/CreateDish", chef, &request{KitchenId: "k1"}, codes.OK},
+		{"chef of another kitchen", "/test/CreateDish", chef, &request{KitchenId: "k2"}, codes.PermissionDenied},
+		{"admin", "/test/CreateDish", admin, &request{KitchenId: "k2"}, codes.OK},
+	}
+	for _, c := range cases {
+		if got := call(c.method, c.claims, c.req); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+// stream is a server stream whose only message is req.
+type stream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req *request
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stream) RecvMsg(m any) error {
+	*m.(*request) = *s.req
+	return nil
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	rules := map[string]Rule{
+		"/test/WatchKitchen": {
+			Roles:   []string{RoleChef},
+			Kitchen: func(req any) string { return req.(*request).KitchenId },
+		},
+	}
+	owner := func(ctx context.Context, kitchenId string) (string, error) {
+		return map[string]string{"k1": "chef1"}[kitchenId], nil
+	}
+	interceptor := StreamServerInterceptor(NewJWTVerifier(secret), rules, owner)
+
+	call := func(method string, claims *Claims, req *request) codes.Code {
+		ctx := context.Background()
+		if claims != nil {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token(t, *claims)))
+		}
+		err := interceptor(nil, &stream{ctx: ctx, req: req}, &grpc.StreamServerInfo{FullMethod: method},
+			func(srv any, ss grpc.ServerStream) error {
+				if err := ss.RecvMsg(&request{}); err != nil {
+					return err
+				}
+				if _, ok := FromContext(ss.Context()); !ok {
+					t.Errorf("%s: principal missing from stream context", method)
+				}
+				return nil
+			})
+		return status.Code(err)
+	}
+
+	exp := time.Now().Add(time.Hour).Unix()
+	chef := &Claims{Id: "chef1", UserType: RoleChef, Expires: exp}
+	customer := &Claims{Id: "user1", UserType: RoleCustomer, Expires: exp}
+
+	cases := []struct {
+		name   string
+		method string
+		claims *Claims
+		req    *request
+		want   codes.Code
+	}{
+		{"missing token", "/test/WatchKitchen", nil, &request{KitchenId: "k1"}, codes.Unauthenticated},
+		{"unknown method", "/test/Unknown", chef, &request{}, codes.PermissionDenied},
+		{"chef of the kitchen", "/test/WatchKitchen", chef, &request{KitchenId: "k1"}, codes.OK},
+		{"chef of another kitchen", "/test/WatchKitchen", chef, &request{KitchenId: "k2"}, codes.PermissionDenied},
+		{"customer", "/test/WatchKitchen", customer, &request{KitchenId: "k1"}, codes.PermissionDenied},
+	}
+	for _, c := range cases {
+		if got := call(c.method, c.claims, c.req); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
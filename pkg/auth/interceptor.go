@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Rule is the access rule of a single RPC. Admins pass every rule.
+type Rule struct {
+	// Public RPCs need no access token.
+	Public bool
+	// Roles that may call the RPC. Empty means any authenticated caller.
+	Roles []string
+	// Self returns the user the request acts for. Customers may only act for
+	// themselves.
+	Self func(req any) string
+	// Kitchen returns the kitchen the request acts on. Chefs may only act on
+	// kitchens they own.
+	Kitchen func(req any) string
+}
+
+// KitchenOwner returns the id of the user owning the kitchen.
+type KitchenOwner func(ctx context.Context, kitchenId string) (string, error)
+
+// UnaryServerInterceptor authenticates the bearer token of every call and
+// enforces the rule of its method. Methods without a rule are denied.
+func UnaryServerInterceptor(verifier *JWTVerifier, rules map[string]Rule, owner KitchenOwner) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		rule, ok := rules[info.FullMethod]
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed", info.FullMethod)
+		}
+		if rule.Public {
+			return handler(ctx, req)
+		}
+
+		principal, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := rule.check(ctx, principal, req, owner); err != nil {
+			return nil, err
+		}
+
+		return handler(NewContext(ctx, principal), req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor. The rule is checked against the first message the
+// handler receives, as Self and Kitchen need the request.
+func StreamServerInterceptor(verifier *JWTVerifier, rules map[string]Rule, owner KitchenOwner) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		rule, ok := rules[info.FullMethod]
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "%s is not allowed", info.FullMethod)
+		}
+		if rule.Public {
+			return handler(srv, ss)
+		}
+
+		principal, err := authenticate(ss.Context(), verifier)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			ctx:          NewContext(ss.Context(), principal),
+			principal:    principal,
+			rule:         rule,
+			owner:        owner,
+		})
+	}
+}
+
+// authorizedStream carries the principal in its context and checks the rule
+// of the method against the first received message.
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	principal *Principal
+	rule      Rule
+	owner     KitchenOwner
+	checked   bool
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.checked {
+		return nil
+	}
+	s.checked = true
+	return s.rule.check(s.ctx, s.principal, m, s.owner)
+}
+
+func authenticate(ctx context.Context, verifier *JWTVerifier) (*Principal, error) {
+	token := bearerToken(ctx)
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "access token is required")
+	}
+	principal, err := verifier.Verify(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return principal, nil
+}
+
+func (r Rule) check(ctx context.Context, p *Principal, req any, owner KitchenOwner) error {
+	if p.IsAdmin() {
+		return nil
+	}
+	if len(r.Roles) > 0 && !contains(r.Roles, p.Role) {
+		return status.Errorf(codes.PermissionDenied, "role %s is not allowed", p.Role)
+	}
+
+	if r.Self != nil && p.Role == RoleCustomer && r.Self(req) != p.UserId {
+		return status.Error(codes.PermissionDenied, "customers may only act for themselves")
+	}
+	if r.Kitchen != nil && p.Role == RoleChef {
+		ownerId, err := owner(ctx, r.Kitchen(req))
+		if err != nil {
+			return err
+		}
+		if ownerId != p.UserId {
+			return status.Error(codes.PermissionDenied, "chefs may only act on their own kitchen")
+		}
+	}
+
+	return nil
+}
+
+// bearerToken reads the token of the authorization metadata, with or without
+// the Bearer prefix.
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return ""
+	}
+
+	token := strings.TrimSpace(values[0])
+	if len(token) > 7 && strings.EqualFold(token[:7], "bearer ") {
+		token = strings.TrimSpace(token[7:])
+	}
+	return token
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var ErrInvalidToken = errors.New("invalid access token")
+
+// Claims are the access token claims issued by the auth service.
+type Claims struct {
+	Id       string `json:"id"`
+	Username string `json:"username,omitempty"`
+	Email    string `json:"email,omitempty"`
+	UserType string `json:"user_type"`
+	Expires  int64  `json:"exp"`
+}
+
+// JWTVerifier verifies HS256 access tokens signed with the secret shared with
+// the auth service.
+type JWTVerifier struct {
+	secret []byte
+	now    func() time.Time
+}
+
+func NewJWTVerifier(secret string) *JWTVerifier {
+	return &JWTVerifier{secret: []byte(secret), now: time.Now}
+}
+
+func (v *JWTVerifier) Verify(token string) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	header := struct {
+		Alg string `json:"alg"`
+	}{}
+	if err := decodeSegment(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return nil, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, v.sign(parts[0]+"."+parts[1])) {
+		return nil, ErrInvalidToken
+	}
+
+	claims := Claims{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if claims.Id == "" || claims.UserType == "" {
+		return nil, ErrInvalidToken
+	}
+	if claims.Expires == 0 {
+		return nil, ErrInvalidToken
+	}
+	if v.now().Unix() >= claims.Expires {
+		return nil, errors.New("access token expired")
+	}
+
+	return &Principal{UserId: claims.Id, Role: claims.UserType}, nil
+}
+
+func (v *JWTVerifier) sign(data string) []byte {
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
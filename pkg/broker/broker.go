@@ -0,0 +1,57 @@
+package broker
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Event is a domain event of the service as it is handed to a broker. Payload
+// holds the JSON encoded details of the event.
+type Event struct {
+	Id            string
+	Type          string
+	AggregateType string
+	AggregateId   string
+	Payload       []byte
+	OccurredAt    time.Time
+}
+
+// Broker delivers domain events to other services. An event may be published
+// more than once when a relay fails midway, so consumers deduplicate by Id.
+type Broker interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// Memory is an in-process Broker for tests. When Fail is set, Publish fails
+// with the error it returns for an event.
+type Memory struct {
+	mu     sync.Mutex
+	events []Event
+	Fail   func(event Event) error
+}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Publish(ctx context.Context, event Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.Fail != nil {
+		if err := m.Fail(event); err != nil {
+			return err
+		}
+	}
+	m.events = append(m.events, event)
+	return nil
+}
+
+// Events returns the published events in order.
+func (m *Memory) Events() []Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Event(nil), m.events...)
+}
@@ -0,0 +1,36 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMemory(t *testing.T) {
+	m := NewMemory()
+	ctx := context.Background()
+
+	for _, id := range []string{"e1", "e2"} {
+		if err := m.Publish(ctx, Event{Id: id, Type: "OrderCreated"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m.Fail = func(event Event) error {
+		if event.Id == "e3" {
+			return errors.New("broker down")
+		}
+		return nil
+	}
+	if err := m.Publish(ctx, Event{Id: "e3"}); err == nil {
+		t.Error("expected the configured error")
+	}
+
+	events := m.Events()
+	if len(events) != 2 || events[0].Id != "e1" || events[1].Id != "e2" {
+		t.Errorf("unexpected events %+v", events)
+	}
+	events[0].Id = "changed"
+	if m.Events()[0].Id != "e1" {
+		t.Error("Events must return a copy")
+	}
+}
@@ -0,0 +1,31 @@
+package cancellation
+
+import (
+	"errors"
+	"order_service/pkg/lifecycle"
+	"order_service/pkg/money"
+)
+
+// ErrTooLate is returned for orders that were dispatched or already finished.
+var ErrTooLate = errors.New("order can no longer be cancelled")
+
+// Policy decides what a customer pays for cancelling an order, depending on
+// how far the kitchen got with it. Cancelling is free until the kitchen
+// accepts the order, costs PreparingFeePercent of the total while the kitchen
+// works on it and is not possible after dispatch.
+type Policy struct {
+	PreparingFeePercent int64
+}
+
+// Fee returns the part of the order total kept when an order in the given
+// status is cancelled. Fees are rounded down to the minor unit.
+func (p Policy) Fee(status string, total money.Money) (money.Money, error) {
+	switch status {
+	case lifecycle.Scheduled, lifecycle.Pending, lifecycle.Paid:
+		return money.New(0, total.Currency), nil
+	case lifecycle.Accepted, lifecycle.Preparing, lifecycle.Ready:
+		return money.New(total.Amount*p.PreparingFeePercent/100, total.Currency), nil
+	default:
+		return money.Money{}, ErrTooLate
+	}
+}
@@ -0,0 +1,40 @@
+package cancellation
+
+import (
+	"order_service/pkg/lifecycle"
+	"order_service/pkg/money"
+	"testing"
+)
+
+func TestFee(t *testing.T) {
+	policy := Policy{PreparingFeePercent: 20}
+	total := money.New(12345, "USD")
+
+	cases := []struct {
+		status string
+		want   int64
+	}{
+		{lifecycle.Scheduled, 0},
+		{lifecycle.Pending, 0},
+		{lifecycle.Paid, 0},
+		{lifecycle.Accepted, 2469},
+		{lifecycle.Preparing, 2469},
+		{lifecycle.Ready, 2469},
+	}
+	for _, c := range cases {
+		fee, err := policy.Fee(c.status, total)
+		if err != nil {
+			t.Errorf("%s: %v", c.status, err)
+			continue
+		}
+		if fee.Amount != c.want || fee.Currency != "USD" {
+			t.Errorf("%s: fee = %v, want %d USD", c.status, fee, c.want)
+		}
+	}
+
+	for _, status := range []string{lifecycle.OutForDelivery, lifecycle.Delivered, lifecycle.Cancelled, lifecycle.Rejected} {
+		if _, err := policy.Fee(status, total); err != ErrTooLate {
+			t.Errorf("%s: err = %v, want ErrTooLate", status, err)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalid is returned for cursors that were not produced by Encode.
+var ErrInvalid = errors.New("invalid page cursor")
+
+// Encode turns the sort keys of the last row of a page into an opaque cursor
+// clients pass back to get the next page.
+func Encode(keys any) (string, error) {
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Decode reads the sort keys of a cursor made by Encode into keys.
+func Decode(cursor string, keys any) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return ErrInvalid
+	}
+	if err := json.Unmarshal(data, keys); err != nil {
+		return ErrInvalid
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package cursor
+
+import "testing"
+
+type keys struct {
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+	Rating    int32  `json:"rating"`
+}
+
+func TestRoundTrip(t *testing.T) {
+	in := keys{CreatedAt: "2024-07-17T12:00:00.123456Z", Id: "5d2b7c1e-3a4f-4e6b-9c8d-7e6f5a4b3c2d", Rating: 4}
+
+	c, err := Encode(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := keys{}
+	if err := Decode(c, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out != in {
+		t.Errorf("got %+v, want %+v", out, in)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	for _, c := range []string{"not base64!", "bm90IGpzb24"} {
+		if err := Decode(c, &keys{}); err != ErrInvalid {
+			t.Errorf("%q: expected ErrInvalid, got %v", c, err)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package eta
+
+import (
+	"order_service/pkg/lifecycle"
+	"time"
+)
+
+// Order is what the delivery estimate of an order is based on.
+type Order struct {
+	Status string
+	// PrepTime is the longest preparation time of the dishes of the order;
+	// the dishes of one order are cooked side by side.
+	PrepTime time.Duration
+	// QueuePrepTime sums the preparation times of the orders the kitchen
+	// works through before this one.
+	QueuePrepTime time.Duration
+	// Route is the duration of the delivery route, zero while it is unknown.
+	Route time.Duration
+}
+
+// Estimator turns the state of a kitchen into the expected delivery time of
+// an order.
+type Estimator struct {
+	// Parallel is the number of orders a kitchen prepares at once.
+	Parallel int
+	// DefaultRoute is assumed for orders without a delivery route.
+	DefaultRoute time.Duration
+}
+
+// Estimate returns when an order that reached its status at since is expected
+// to be delivered. Only the stages still ahead of the order are counted. It
+// returns false for scheduled orders, whose delivery time is fixed, and for
+// orders that are finished.
+func (e Estimator) Estimate(o Order, since time.Time) (time.Time, bool) {
+	route := o.Route
+	if route <= 0 {
+		route = e.DefaultRoute
+	}
+
+	switch o.Status {
+	case lifecycle.Pending, lifecycle.Paid, lifecycle.Accepted:
+		parallel := e.Parallel
+		if parallel < 1 {
+			parallel = 1
+		}
+		wait := o.QueuePrepTime / time.Duration(parallel)
+		return since.Add(wait + o.PrepTime + route), true
+	case lifecycle.Preparing:
+		return since.Add(o.PrepTime + route), true
+	case lifecycle.Ready, lifecycle.OutForDelivery:
+		return since.Add(route), true
+	default:
+		return time.Time{}, false
+	}
+}
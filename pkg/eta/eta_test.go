@@ -0,0 +1,49 @@
+package eta
+
+import (
+	"order_service/pkg/lifecycle"
+	"testing"
+	"time"
+)
+
+func TestEstimate(t *testing.T) {
+	e := Estimator{Parallel: 2, DefaultRoute: 15 * time.Minute}
+	since := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	order := Order{PrepTime: 20 * time.Minute, QueuePrepTime: 60 * time.Minute, Route: 10 * time.Minute}
+
+	cases := []struct {
+		status string
+		want   time.Duration
+	}{
+		{lifecycle.Pending, 30*time.Minute + 20*time.Minute + 10*time.Minute},
+		{lifecycle.Paid, 60 * time.Minute},
+		{lifecycle.Accepted, 60 * time.Minute},
+		{lifecycle.Preparing, 30 * time.Minute},
+		{lifecycle.Ready, 10 * time.Minute},
+		{lifecycle.OutForDelivery, 10 * time.Minute},
+	}
+	for _, c := range cases {
+		order.Status = c.status
+		got, ok := e.Estimate(order, since)
+		if !ok || got.Sub(since) != c.want {
+			t.Errorf("%s: estimate = %s, %v, want %s", c.status, got.Sub(since), ok, c.want)
+		}
+	}
+
+	for _, status := range []string{lifecycle.Scheduled, lifecycle.Delivered, lifecycle.Cancelled, lifecycle.Rejected} {
+		order.Status = status
+		if _, ok := e.Estimate(order, since); ok {
+			t.Errorf("%s: expected no estimate", status)
+		}
+	}
+}
+
+func TestEstimateDefaults(t *testing.T) {
+	since := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	order := Order{Status: lifecycle.Pending, PrepTime: 10 * time.Minute, QueuePrepTime: 30 * time.Minute}
+
+	got, _ := Estimator{DefaultRoute: 15 * time.Minute}.Estimate(order, since)
+	if want := 55 * time.Minute; got.Sub(since) != want {
+		t.Errorf("estimate = %s, want %s", got.Sub(since), want)
+	}
+}
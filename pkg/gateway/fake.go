@@ -0,0 +1,133 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"order_service/pkg/money"
+	"order_service/pkg/vault"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Test card numbers recognised by Fake, following the usual processor
+// conventions. Every other card is approved.
+const (
+	DeclinedCard          = "4000000000000002"
+	InsufficientFundsCard = "4000000000009995"
+	CaptureFailsCard      = "4000000000000341"
+)
+
+type transaction struct {
+	card       string
+	authorized money.Money
+	captured   money.Money
+	refunded   money.Money
+	voided     bool
+}
+
+// Fake is an in-process PaymentGateway for tests and local runs. It resolves
+// card tokens through the same vault the service tokenizes cards with.
+type Fake struct {
+	mu           sync.Mutex
+	vault        vault.Vault
+	transactions map[string]*transaction
+}
+
+func NewFake(v vault.Vault) *Fake {
+	return &Fake{vault: v, transactions: map[string]*transaction{}}
+}
+
+func (f *Fake) Authorize(ctx context.Context, req AuthorizeRequest) (string, error) {
+	card, err := f.vault.Detokenize(ctx, req.CardToken)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrDeclined, err)
+	}
+
+	switch card.Number {
+	case DeclinedCard:
+		return "", fmt.Errorf("%w: card declined", ErrDeclined)
+	case InsufficientFundsCard:
+		return "", fmt.Errorf("%w: insufficient funds", ErrDeclined)
+	}
+	if req.Amount.Amount <= 0 {
+		return "", fmt.Errorf("%w: amount must be positive", ErrDeclined)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := "fake_" + uuid.NewString()
+	f.transactions[id] = &transaction{card: card.Number, authorized: req.Amount}
+
+	return id, nil
+}
+
+func (f *Fake) Capture(ctx context.Context, transactionId string, amount money.Money) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t, err := f.get(transactionId)
+	if err != nil {
+		return err
+	}
+	if t.card == CaptureFailsCard {
+		return fmt.Errorf("%w: capture failed", ErrDeclined)
+	}
+	if t.voided {
+		return fmt.Errorf("%w: authorization was voided", ErrDeclined)
+	}
+	if !t.captured.IsZero() {
+		return fmt.Errorf("%w: already captured", ErrDeclined)
+	}
+	if amount.Currency != t.authorized.Currency || amount.Amount > t.authorized.Amount {
+		return fmt.Errorf("%w: capture exceeds authorized amount", ErrDeclined)
+	}
+	t.captured = amount
+
+	return nil
+}
+
+func (f *Fake) Void(ctx context.Context, transactionId string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t, err := f.get(transactionId)
+	if err != nil {
+		return err
+	}
+	if !t.captured.IsZero() {
+		return fmt.Errorf("%w: captured transactions must be refunded", ErrDeclined)
+	}
+	t.voided = true
+
+	return nil
+}
+
+func (f *Fake) Refund(ctx context.Context, transactionId string, amount money.Money) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t, err := f.get(transactionId)
+	if err != nil {
+		return err
+	}
+	refunded, err := t.refunded.Add(amount)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDeclined, err)
+	}
+	if amount.Amount <= 0 || refunded.Amount > t.captured.Amount {
+		return fmt.Errorf("%w: refund exceeds captured amount", ErrDeclined)
+	}
+	t.refunded = refunded
+
+	return nil
+}
+
+func (f *Fake) get(transactionId string) (*transaction, error) {
+	t, ok := f.transactions[transactionId]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown transaction %s", ErrDeclined, transactionId)
+	}
+	return t, nil
+}
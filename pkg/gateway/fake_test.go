@@ -0,0 +1,79 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"order_service/pkg/money"
+	"order_service/pkg/vault"
+	"testing"
+)
+
+func newFake(t *testing.T) (*Fake, func(number string) string) {
+	v, err := vault.NewLocal("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenize := func(number string) string {
+		token, err := v.Tokenize(context.Background(), vault.Card{Number: number, ExpiryDate: "12/30"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token.Token
+	}
+
+	return NewFake(v), tokenize
+}
+
+func TestFakeLifecycle(t *testing.T) {
+	ctx := context.Background()
+	f, tokenize := newFake(t)
+	amount := money.New(5000, "UZS")
+
+	id, err := f.Authorize(ctx, AuthorizeRequest{OrderId: "o1", Amount: amount, CardToken: tokenize("4242424242424242")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Capture(ctx, id, money.New(6000, "UZS")); !errors.Is(err, ErrDeclined) {
+		t.Errorf("expected capture above authorization to be declined, got %v", err)
+	}
+	if err := f.Capture(ctx, id, amount); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Void(ctx, id); err == nil {
+		t.Error("expected void of a captured transaction to fail")
+	}
+	if err := f.Refund(ctx, id, money.New(3000, "UZS")); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Refund(ctx, id, money.New(3000, "UZS")); !errors.Is(err, ErrDeclined) {
+		t.Errorf("expected refund above captured amount to be declined, got %v", err)
+	}
+}
+
+func TestFakeDeclines(t *testing.T) {
+	ctx := context.Background()
+	f, tokenize := newFake(t)
+	amount := money.New(5000, "UZS")
+
+	for _, card := range []string{DeclinedCard, InsufficientFundsCard} {
+		_, err := f.Authorize(ctx, AuthorizeRequest{Amount: amount, CardToken: tokenize(card)})
+		if !errors.Is(err, ErrDeclined) {
+			t.Errorf("expected card %s to be declined, got %v", card, err)
+		}
+	}
+
+	id, err := f.Authorize(ctx, AuthorizeRequest{Amount: amount, CardToken: tokenize(CaptureFailsCard)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Capture(ctx, id, amount); !errors.Is(err, ErrDeclined) {
+		t.Errorf("expected capture to fail, got %v", err)
+	}
+	if err := f.Void(ctx, id); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := f.Authorize(ctx, AuthorizeRequest{Amount: amount, CardToken: "tok_unknown"}); !errors.Is(err, ErrDeclined) {
+		t.Errorf("expected unknown token to be declined, got %v", err)
+	}
+}
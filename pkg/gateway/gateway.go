@@ -0,0 +1,30 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"order_service/pkg/money"
+)
+
+// ErrDeclined is returned, wrapped with the reason, when the gateway refuses
+// an operation. Other errors mean the gateway could not be reached.
+var ErrDeclined = errors.New("payment declined")
+
+// AuthorizeRequest identifies the card by its vault token. The CVV is passed
+// through for this single call and must never be stored or logged.
+type AuthorizeRequest struct {
+	OrderId   string
+	Amount    money.Money
+	CardToken string
+	Cvv       string
+}
+
+// PaymentGateway is the card processor payments are charged through. An
+// authorization reserves the amount, capture charges it, void releases an
+// uncaptured authorization and refund returns captured money.
+type PaymentGateway interface {
+	Authorize(ctx context.Context, req AuthorizeRequest) (transactionId string, err error)
+	Capture(ctx context.Context, transactionId string, amount money.Money) error
+	Void(ctx context.Context, transactionId string) error
+	Refund(ctx context.Context, transactionId string, amount money.Money) error
+}
@@ -0,0 +1,175 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"order_service/pkg/auth"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// MetadataKey is the gRPC metadata header clients send the idempotency key in.
+const MetadataKey = "idempotency-key"
+
+// LockTTL bounds how long the reservation of a running request blocks its key,
+// so a key is usable again soon after the process dies mid-request. It must
+// be longer than the slowest request.
+const LockTTL = time.Minute
+
+var (
+	// ErrConflict is returned when a key is reused with a different payload.
+	ErrConflict = errors.New("idempotency key was already used with a different request")
+	// ErrInProgress is returned while the first request with a key is running.
+	ErrInProgress = errors.New("request with this idempotency key is still in progress")
+	// ErrNotSaved is returned together with the response of a request that
+	// succeeded but whose response could not be stored for replays.
+	ErrNotSaved = errors.New("idempotent response could not be saved")
+)
+
+// Record is what is kept for a key: the fingerprint of the request and, once
+// it succeeded, its marshalled response.
+type Record struct {
+	Fingerprint string `json:"fingerprint"`
+	Response    []byte `json:"response,omitempty"`
+	Done        bool   `json:"done"`
+}
+
+// Store keeps records for ttl. Reserve stores record only if the key is free
+// and otherwise returns the record already stored.
+type Store interface {
+	Reserve(ctx context.Context, key string, record Record, ttl time.Duration) (existing *Record, reserved bool, err error)
+	Save(ctx context.Context, key string, record Record, ttl time.Duration) error
+	Release(ctx context.Context, key string) error
+}
+
+// KeyFromContext returns the idempotency key of the incoming request, or an
+// empty string when the client did not send one.
+func KeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Fingerprint hashes the deterministic encoding of the request.
+func Fingerprint(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Do runs fn at most once per idempotency key of the incoming request and
+// scope. Keys are kept per authenticated caller, so that the keys of different
+// users never collide. A retry with the same payload replays the stored response into res;
+// failed attempts are forgotten so that they can be retried. Requests without
+// a key always run fn. When the response cannot be saved, Do returns it with
+// ErrNotSaved, since fn has already taken effect.
+func Do[T proto.Message](ctx context.Context, store Store, ttl time.Duration, scope string, req proto.Message, res T, fn func() (T, error)) (T, error) {
+	key := KeyFromContext(ctx)
+	if key == "" {
+		return fn()
+	}
+	if principal, ok := auth.FromContext(ctx); ok {
+		key = principal.UserId + ":" + key
+	}
+	key = scope + ":" + key
+
+	var zero T
+	fingerprint, err := Fingerprint(req)
+	if err != nil {
+		return zero, err
+	}
+
+	existing, reserved, err := store.Reserve(ctx, key, Record{Fingerprint: fingerprint}, min(LockTTL, ttl))
+	if err != nil {
+		return zero, err
+	}
+	if !reserved {
+		switch {
+		case existing.Fingerprint != fingerprint:
+			return zero, ErrConflict
+		case !existing.Done:
+			return zero, ErrInProgress
+		}
+		if err := proto.Unmarshal(existing.Response, res); err != nil {
+			return zero, err
+		}
+		return res, nil
+	}
+
+	out, err := fn()
+	if err != nil {
+		// The release error is dropped: the reservation expires with ttl.
+		_ = store.Release(ctx, key)
+		return zero, err
+	}
+
+	response, err := proto.Marshal(out)
+	if err != nil {
+		return zero, err
+	}
+	err = store.Save(ctx, key, Record{Fingerprint: fingerprint, Response: response, Done: true}, ttl)
+	if err != nil {
+		return out, fmt.Errorf("%w: %v", ErrNotSaved, err)
+	}
+
+	return out, nil
+}
+
+type entry struct {
+	record  Record
+	expires time.Time
+}
+
+// Memory is an in-process Store for tests and local runs.
+type Memory struct {
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+func NewMemory() *Memory {
+	return &Memory{entries: map[string]entry{}}
+}
+
+func (m *Memory) Reserve(ctx context.Context, key string, record Record, ttl time.Duration) (*Record, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.entries[key]; ok && time.Now().Before(e.expires) {
+		existing := e.record
+		return &existing, false, nil
+	}
+	m.entries[key] = entry{record: record, expires: time.Now().Add(ttl)}
+
+	return nil, true, nil
+}
+
+func (m *Memory) Save(ctx context.Context, key string, record Record, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[key] = entry{record: record, expires: time.Now().Add(ttl)}
+	return nil
+}
+
+func (m *Memory) Release(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, key)
+	return nil
+}
@@ -0,0 +1,149 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "order_service/genproto/order"
+	"order_service/pkg/auth"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func withKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, key))
+}
+
+func TestDoReplaysResponse(t *testing.T) {
+	store := NewMemory()
+	req := &pb.ReqCreateOrder{UserId: "u1", KitchenId: "k1"}
+	calls := 0
+	create := func() (*pb.OrderInfo, error) {
+		calls++
+		return &pb.OrderInfo{Id: "order-1", UserId: req.UserId}, nil
+	}
+
+	first, err := Do(withKey("abc"), store, time.Hour, "CreateOrder", req, &pb.OrderInfo{}, create)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := Do(withKey("abc"), store, time.Hour, "CreateOrder", req, &pb.OrderInfo{}, create)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected the handler to run once, ran %d times", calls)
+	}
+	if second.Id != first.Id {
+		t.Errorf("expected replayed order %s, got %s", first.Id, second.Id)
+	}
+}
+
+func TestDoConflict(t *testing.T) {
+	store := NewMemory()
+	create := func() (*pb.OrderInfo, error) { return &pb.OrderInfo{Id: "order-1"}, nil }
+
+	_, err := Do(withKey("abc"), store, time.Hour, "CreateOrder", &pb.ReqCreateOrder{UserId: "u1"}, &pb.OrderInfo{}, create)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Do(withKey("abc"), store, time.Hour, "CreateOrder", &pb.ReqCreateOrder{UserId: "u2"}, &pb.OrderInfo{}, create)
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("expected ErrConflict, got %v", err)
+	}
+}
+
+func TestDoKeysPerCaller(t *testing.T) {
+	store := NewMemory()
+	calls := 0
+	create := func() (*pb.OrderInfo, error) {
+		calls++
+		return &pb.OrderInfo{}, nil
+	}
+
+	for _, user := range []string{"u1", "u2"} {
+		ctx := auth.NewContext(withKey("abc"), &auth.Principal{UserId: user})
+		_, err := Do(ctx, store, time.Hour, "CreateOrder", &pb.ReqCreateOrder{UserId: user}, &pb.OrderInfo{}, create)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("expected the handler to run for each caller, ran %d times", calls)
+	}
+}
+
+func TestDoRetriesFailures(t *testing.T) {
+	store := NewMemory()
+	req := &pb.ReqCreateOrder{UserId: "u1"}
+	calls := 0
+	create := func() (*pb.OrderInfo, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("kitchen is closed")
+		}
+		return &pb.OrderInfo{Id: "order-1"}, nil
+	}
+
+	if _, err := Do(withKey("abc"), store, time.Hour, "CreateOrder", req, &pb.OrderInfo{}, create); err == nil {
+		t.Fatal("expected the first attempt to fail")
+	}
+	if _, err := Do(withKey("abc"), store, time.Hour, "CreateOrder", req, &pb.OrderInfo{}, create); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("expected the handler to run twice, ran %d times", calls)
+	}
+}
+
+func TestDoWithoutKey(t *testing.T) {
+	store := NewMemory()
+	calls := 0
+	create := func() (*pb.OrderInfo, error) {
+		calls++
+		return &pb.OrderInfo{}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := Do(context.Background(), store, time.Hour, "CreateOrder", &pb.ReqCreateOrder{}, &pb.OrderInfo{}, create); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("expected the handler to run for every request without a key, ran %d times", calls)
+	}
+}
+
+// failingStore records the reservation ttl and fails every Save.
+type failingStore struct {
+	*Memory
+	reservedFor time.Duration
+}
+
+func (s *failingStore) Reserve(ctx context.Context, key string, record Record, ttl time.Duration) (*Record, bool, error) {
+	s.reservedFor = ttl
+	return s.Memory.Reserve(ctx, key, record, ttl)
+}
+
+func (s *failingStore) Save(ctx context.Context, key string, record Record, ttl time.Duration) error {
+	return errors.New("store is down")
+}
+
+func TestDoReturnsResponseWhenSaveFails(t *testing.T) {
+	store := &failingStore{Memory: NewMemory()}
+	create := func() (*pb.OrderInfo, error) { return &pb.OrderInfo{Id: "order-1"}, nil }
+
+	res, err := Do(withKey("abc"), store, 24*time.Hour, "CreateOrder", &pb.ReqCreateOrder{}, &pb.OrderInfo{}, create)
+	if !errors.Is(err, ErrNotSaved) {
+		t.Errorf("expected ErrNotSaved, got %v", err)
+	}
+	if res.GetId() != "order-1" {
+		t.Errorf("expected the created order to be returned, got %v", res)
+	}
+	if store.reservedFor != LockTTL {
+		t.Errorf("expected the reservation to last %v, got %v", LockTTL, store.reservedFor)
+	}
+}
@@ -0,0 +1,51 @@
+package lifecycle
+
+const (
+	Scheduled      = "scheduled"
+	Pending        = "pending"
+	Paid           = "paid"
+	Accepted       = "accepted"
+	Preparing      = "preparing"
+	Ready          = "ready"
+	OutForDelivery = "out_for_delivery"
+	Delivered      = "delivered"
+	Cancelled      = "cancelled"
+	Rejected       = "rejected"
+)
+
+// transitions lists, for every order status, the statuses it may move to.
+// Orders paid by card go through Paid once the payment is captured; cash and
+// pay later orders are accepted straight from Pending. Orders for a later
+// delivery time wait in Scheduled until they are released to the kitchen,
+// as Paid when their payment was captured already.
+var transitions = map[string][]string{
+	Scheduled:      {Pending, Paid, Cancelled},
+	Pending:        {Paid, Accepted, Rejected, Cancelled},
+	Paid:           {Accepted, Rejected, Cancelled},
+	Accepted:       {Preparing, Cancelled},
+	Preparing:      {Ready, Cancelled},
+	Ready:          {OutForDelivery, Cancelled},
+	OutForDelivery: {Delivered},
+	Delivered:      {},
+	Cancelled:      {},
+	Rejected:       {},
+}
+
+func IsValid(status string) bool {
+	_, ok := transitions[status]
+	return ok
+}
+
+func CanTransition(from, to string) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+func IsFinal(status string) bool {
+	next, ok := transitions[status]
+	return ok && len(next) == 0
+}
@@ -0,0 +1,47 @@
+package lifecycle
+
+import "testing"
+
+func TestCanTransition(t *testing.T) {
+	cases := []struct {
+		from, to string
+		want     bool
+	}{
+		{Scheduled, Pending, true},
+		{Scheduled, Paid, true},
+		{Scheduled, Cancelled, true},
+		{Scheduled, Accepted, false},
+		{Pending, Scheduled, false},
+		{Pending, Accepted, true},
+		{Pending, Rejected, true},
+		{Pending, Paid, true},
+		{Paid, Accepted, true},
+		{Paid, Pending, false},
+		{Accepted, Preparing, true},
+		{Preparing, Ready, true},
+		{Ready, OutForDelivery, true},
+		{OutForDelivery, Delivered, true},
+		{Pending, Delivered, false},
+		{Delivered, Pending, false},
+		{OutForDelivery, Cancelled, false},
+		{Cancelled, Accepted, false},
+		{"delivering", Delivered, false},
+	}
+
+	for _, c := range cases {
+		if got := CanTransition(c.from, c.to); got != c.want {
+			t.Errorf("CanTransition(%q, %q) = %v, want %v", c.from, c.to, got, c.want)
+		}
+	}
+}
+
+func TestIsFinal(t *testing.T) {
+	for _, s := range []string{Delivered, Cancelled, Rejected} {
+		if !IsFinal(s) {
+			t.Errorf("expected %q to be final", s)
+		}
+	}
+	if IsFinal(Preparing) || IsFinal("unknown") {
+		t.Error("expected preparing and unknown statuses not to be final")
+	}
+}
@@ -0,0 +1,195 @@
+package lookup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Cache keeps encoded values by key. Keys missing from the result of GetMany
+// are misses.
+type Cache interface {
+	GetMany(ctx context.Context, keys []string) (map[string][]byte, error)
+	SetMany(ctx context.Context, values map[string][]byte, ttl time.Duration) error
+}
+
+// Fetch loads the value of a single id from its owning service.
+type Fetch[T any] func(ctx context.Context, id string) (T, error)
+
+// Lookup resolves ids of another service in batches. Every distinct id is
+// served from the cache or fetched once, with at most Workers fetches in
+// flight.
+type Lookup[T any] struct {
+	prefix  string
+	fetch   Fetch[T]
+	cache   Cache
+	ttl     time.Duration
+	workers int
+}
+
+// New creates a lookup whose cache keys start with prefix. A nil cache
+// disables caching.
+func New[T any](prefix string, fetch Fetch[T], cache Cache, ttl time.Duration, workers int) *Lookup[T] {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Lookup[T]{prefix: prefix, fetch: fetch, cache: cache, ttl: ttl, workers: workers}
+}
+
+// Get returns the values of the ids that could be resolved. The error joins
+// the failures of the other ids and of the cache; values found are returned
+// either way.
+func (l *Lookup[T]) Get(ctx context.Context, ids []string) (map[string]T, error) {
+	values := map[string]T{}
+	missing := distinct(ids)
+	if len(missing) == 0 {
+		return values, nil
+	}
+
+	var errs []error
+	if l.cache != nil {
+		missing, errs = l.fromCache(ctx, missing, values)
+	}
+
+	fetched, fetchErrs := l.fetchAll(ctx, missing)
+	errs = append(errs, fetchErrs...)
+
+	encoded := map[string][]byte{}
+	for id, v := range fetched {
+		values[id] = v
+		if data, err := json.Marshal(v); err == nil {
+			encoded[l.prefix+id] = data
+		}
+	}
+	if l.cache != nil && len(encoded) > 0 {
+		if err := l.cache.SetMany(ctx, encoded, l.ttl); err != nil {
+			errs = append(errs, fmt.Errorf("cache %s: %w", l.prefix, err))
+		}
+	}
+
+	return values, errors.Join(errs...)
+}
+
+// fromCache fills values from the cache and returns the ids still missing.
+func (l *Lookup[T]) fromCache(ctx context.Context, ids []string, values map[string]T) ([]string, []error) {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = l.prefix + id
+	}
+
+	cached, err := l.cache.GetMany(ctx, keys)
+	if err != nil {
+		return ids, []error{fmt.Errorf("cache %s: %w", l.prefix, err)}
+	}
+
+	missing := []string{}
+	for _, id := range ids {
+		data, ok := cached[l.prefix+id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		var v T
+		if err := json.Unmarshal(data, &v); err != nil {
+			missing = append(missing, id)
+			continue
+		}
+		values[id] = v
+	}
+	return missing, nil
+}
+
+func (l *Lookup[T]) fetchAll(ctx context.Context, ids []string) (map[string]T, []error) {
+	type result struct {
+		id    string
+		value T
+		err   error
+	}
+
+	jobs := make(chan string)
+	results := make(chan result)
+	wg := sync.WaitGroup{}
+	for i := 0; i < l.workers && i < len(ids); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				v, err := l.fetch(ctx, id)
+				results <- result{id: id, value: v, err: err}
+			}
+		}()
+	}
+	go func() {
+		for _, id := range ids {
+			jobs <- id
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	values := map[string]T{}
+	var errs []error
+	for r := range results {
+		if r.err != nil {
+			errs = append(errs, fmt.Errorf("%s%s: %w", l.prefix, r.id, r.err))
+			continue
+		}
+		values[r.id] = r.value
+	}
+	return values, errs
+}
+
+func distinct(ids []string) []string {
+	seen := map[string]bool{}
+	res := []string{}
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		res = append(res, id)
+	}
+	return res
+}
+
+type entry struct {
+	value   []byte
+	expires time.Time
+}
+
+// Memory is an in-process Cache for tests and local runs.
+type Memory struct {
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+func NewMemory() *Memory {
+	return &Memory{entries: map[string]entry{}}
+}
+
+func (m *Memory) GetMany(ctx context.Context, keys []string) (map[string][]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	values := map[string][]byte{}
+	for _, key := range keys {
+		if e, ok := m.entries[key]; ok && time.Now().Before(e.expires) {
+			values[key] = e.value
+		}
+	}
+	return values, nil
+}
+
+func (m *Memory) SetMany(ctx context.Context, values map[string][]byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key, value := range values {
+		m.entries[key] = entry{value: value, expires: time.Now().Add(ttl)}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package lookup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type kitchen struct {
+	Name string `json:"name"`
+}
+
+func TestGetDeduplicatesAndCaches(t *testing.T) {
+	var calls int32
+	fetch := func(ctx context.Context, id string) (kitchen, error) {
+		atomic.AddInt32(&calls, 1)
+		return kitchen{Name: "kitchen " + id}, nil
+	}
+	l := New("kitchen:", fetch, NewMemory(), time.Minute, 4)
+
+	ids := []string{"a", "b", "a", "c", "b", ""}
+	values, err := l.Get(context.Background(), ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 3 || values["b"].Name != "kitchen b" {
+		t.Errorf("unexpected values %v", values)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 fetches, got %d", calls)
+	}
+
+	if _, err := l.Get(context.Background(), ids); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("expected cached values to be reused, got %d fetches", calls)
+	}
+}
+
+func TestGetBoundsConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	fetch := func(ctx context.Context, id string) (string, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return id, nil
+	}
+	l := New[string]("user:", fetch, nil, time.Minute, 3)
+
+	ids := make([]string, 50)
+	for i := range ids {
+		ids[i] = fmt.Sprint(i)
+	}
+	values, err := l.Get(context.Background(), ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 50 {
+		t.Errorf("expected 50 values, got %d", len(values))
+	}
+	if peak > 3 {
+		t.Errorf("expected at most 3 fetches in flight, got %d", peak)
+	}
+}
+
+func TestGetPartialFailure(t *testing.T) {
+	notFound := errors.New("not found")
+	fetch := func(ctx context.Context, id string) (string, error) {
+		if id == "missing" {
+			return "", notFound
+		}
+		return id, nil
+	}
+	l := New[string]("user:", fetch, NewMemory(), time.Minute, 2)
+
+	values, err := l.Get(context.Background(), []string{"a", "missing"})
+	if !errors.Is(err, notFound) {
+		t.Errorf("expected the fetch error, got %v", err)
+	}
+	if values["a"] != "a" {
+		t.Errorf("expected the found value to be returned, got %v", values)
+	}
+}
@@ -0,0 +1,131 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// minorUnits is the number of minor units in one major unit. Every currency
+// the service handles has two decimal places, matching DECIMAL(10, 2) columns.
+const minorUnits = 100
+
+// Money is an exact amount in minor units (cents, tiyin) of a currency.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// Proto is satisfied by the Money messages of every genproto package.
+type Proto interface {
+	GetAmount() int64
+	GetCurrency() string
+}
+
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+func FromProto(p Proto) Money {
+	return Money{Amount: p.GetAmount(), Currency: p.GetCurrency()}
+}
+
+// FromFloat converts a legacy floating point amount in major units, rounding
+// to the nearest minor unit.
+func FromFloat(amount float64, currency string) Money {
+	return Money{Amount: int64(math.Round(amount * minorUnits)), Currency: currency}
+}
+
+// Parse reads a decimal amount such as "12.99" as returned by Postgres for
+// DECIMAL columns. An empty string is read as zero.
+func Parse(amount, currency string) (Money, error) {
+	amount = strings.TrimSpace(amount)
+	if amount == "" {
+		return Money{Currency: currency}, nil
+	}
+
+	negative := strings.HasPrefix(amount, "-")
+	amount = strings.TrimPrefix(amount, "-")
+
+	whole, fraction, _ := strings.Cut(amount, ".")
+	if len(fraction) > 2 {
+		return Money{}, fmt.Errorf("amount %q has more than two decimal places", amount)
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+	if whole == "" {
+		whole = "0"
+	}
+
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q: %v", amount, err)
+	}
+	minor, err := strconv.ParseInt(fraction, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q: %v", amount, err)
+	}
+
+	res := major*minorUnits + minor
+	if negative {
+		res = -res
+	}
+
+	return Money{Amount: res, Currency: currency}, nil
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.currency(other)}, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount - other.Amount, Currency: m.currency(other)}, nil
+}
+
+func (m Money) Mul(n int64) Money {
+	return Money{Amount: m.Amount * n, Currency: m.Currency}
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Float returns the amount in major units for the legacy floating point
+// fields of the API. It must not be used for arithmetic.
+func (m Money) Float() float64 {
+	return float64(m.Amount) / minorUnits
+}
+
+// String formats the amount as a decimal in major units, e.g. "12.99", which
+// is also the representation written to DECIMAL columns.
+func (m Money) String() string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, amount/minorUnits, amount%minorUnits)
+}
+
+// sameCurrency allows a zero value without currency to be combined with any
+// currency, so sums can start from Money{}.
+func (m Money) sameCurrency(other Money) error {
+	if m.Currency != "" && other.Currency != "" && m.Currency != other.Currency {
+		return fmt.Errorf("currency mismatch: %s and %s", m.Currency, other.Currency)
+	}
+	return nil
+}
+
+func (m Money) currency(other Money) string {
+	if m.Currency != "" {
+		return m.Currency
+	}
+	return other.Currency
+}
@@ -0,0 +1,65 @@
+package money
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	cases := map[string]int64{
+		"12.99": 1299,
+		"12.9":  1290,
+		"12":    1200,
+		".5":    50,
+		"-3.05": -305,
+		"":      0,
+	}
+
+	for in, want := range cases {
+		got, err := Parse(in, "UZS")
+		if err != nil {
+			t.Errorf("Parse(%q): %v", in, err)
+			continue
+		}
+		if got.Amount != want || got.Currency != "UZS" {
+			t.Errorf("Parse(%q) = %+v, want %d UZS", in, got, want)
+		}
+	}
+
+	for _, in := range []string{"1.234", "abc", "1.x"} {
+		if _, err := Parse(in, "UZS"); err == nil {
+			t.Errorf("Parse(%q): expected an error", in)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	cases := map[int64]string{
+		1299: "12.99",
+		5:    "0.05",
+		-305: "-3.05",
+		0:    "0.00",
+	}
+
+	for in, want := range cases {
+		if got := New(in, "UZS").String(); got != want {
+			t.Errorf("String(%d) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	price := FromFloat(float64(float32(12.99)), "UZS")
+	if price.Amount != 1299 {
+		t.Fatalf("FromFloat rounded to %d", price.Amount)
+	}
+
+	total, err := Money{}.Add(price.Mul(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total.Amount != 3897 || total.Currency != "UZS" {
+		t.Errorf("unexpected total %+v", total)
+	}
+
+	if _, err := total.Add(New(1, "USD")); err == nil {
+		t.Error("expected currency mismatch error")
+	}
+}
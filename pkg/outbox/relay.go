@@ -0,0 +1,120 @@
+package outbox
+
+import (
+	"context"
+	"order_service/pkg/broker"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Pending is a claimed event together with the number of times publishing it
+// has failed so far.
+type Pending struct {
+	broker.Event
+	Attempts int
+}
+
+// Store holds the events waiting to be published. Claim leases up to limit
+// events that are due, oldest first; other relays skip them until the lease
+// ends, so the events of a relay that died are claimed again later.
+type Store interface {
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]Pending, error)
+	MarkPublished(ctx context.Context, id string) error
+	MarkFailed(ctx context.Context, id string, cause string, retryAt time.Time, dead bool) error
+}
+
+type Config struct {
+	BatchSize   int
+	Interval    time.Duration
+	Lease       time.Duration
+	MaxAttempts int
+	// Backoff is the delay after the first failure; it doubles with every
+	// further failure up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// Relay publishes the events of a Store to a broker. An event is published at
+// least once; consumers deduplicate by its id. An event that fails is retried
+// with backoff while later events go ahead, and is given up on as dead after
+// MaxAttempts failures.
+type Relay struct {
+	store  Store
+	broker broker.Broker
+	cfg    Config
+	log    *zap.Logger
+	now    func() time.Time
+}
+
+func NewRelay(store Store, b broker.Broker, cfg Config, log *zap.Logger) *Relay {
+	return &Relay{store: store, broker: b, cfg: cfg, log: log, now: time.Now}
+}
+
+// Run relays due events every interval until ctx is done. A full batch is
+// followed by the next one right away.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		claimed, err := r.RelayBatch(ctx)
+		if err != nil {
+			r.log.Error("failed to relay outbox events ", zap.Error(err))
+		}
+		if err == nil && claimed == r.cfg.BatchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayBatch publishes one batch of due events and returns how many it
+// claimed. Publishing failures are recorded on the events, not returned.
+func (r *Relay) RelayBatch(ctx context.Context) (int, error) {
+	events, err := r.store.Claim(ctx, r.cfg.BatchSize, r.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		publishErr := r.broker.Publish(ctx, event.Event)
+		if publishErr == nil {
+			if err := r.store.MarkPublished(ctx, event.Id); err != nil {
+				return len(events), err
+			}
+			continue
+		}
+
+		attempts := event.Attempts + 1
+		dead := attempts >= r.cfg.MaxAttempts
+		err := r.store.MarkFailed(ctx, event.Id, publishErr.Error(), r.now().Add(r.backoff(attempts)), dead)
+		if err != nil {
+			return len(events), err
+		}
+		if dead {
+			r.log.Error("outbox event is dead ", zap.String("event_id", event.Id), zap.Int("attempts", attempts),
+				zap.Error(publishErr))
+		} else {
+			r.log.Info("failed to publish outbox event ", zap.String("event_id", event.Id),
+				zap.Int("attempts", attempts), zap.Error(publishErr))
+		}
+	}
+
+	return len(events), nil
+}
+
+// backoff returns the delay before the next attempt after the given number of
+// failures.
+func (r *Relay) backoff(attempts int) time.Duration {
+	delay := r.cfg.Backoff
+	for i := 1; i < attempts && delay < r.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, r.cfg.MaxBackoff)
+}
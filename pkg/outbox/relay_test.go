@@ -0,0 +1,158 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"order_service/pkg/broker"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// row is an outbox event as memoryStore keeps it.
+type row struct {
+	event     Pending
+	published bool
+	dead      bool
+	lastError string
+	dueAt     time.Time
+}
+
+// memoryStore is a Store over a slice that hands out due events in order.
+type memoryStore struct {
+	rows []*row
+	now  time.Time
+}
+
+func (s *memoryStore) Claim(ctx context.Context, limit int, lease time.Duration) ([]Pending, error) {
+	claimed := []Pending{}
+	for _, r := range s.rows {
+		if len(claimed) == limit {
+			break
+		}
+		if r.published || r.dead || r.dueAt.After(s.now) {
+			continue
+		}
+		r.dueAt = s.now.Add(lease)
+		claimed = append(claimed, r.event)
+	}
+	return claimed, nil
+}
+
+func (s *memoryStore) MarkPublished(ctx context.Context, id string) error {
+	s.find(id).published = true
+	return nil
+}
+
+func (s *memoryStore) MarkFailed(ctx context.Context, id string, cause string, retryAt time.Time, dead bool) error {
+	r := s.find(id)
+	r.event.Attempts++
+	r.lastError, r.dueAt, r.dead = cause, retryAt, dead
+	return nil
+}
+
+func (s *memoryStore) find(id string) *row {
+	for _, r := range s.rows {
+		if r.event.Id == id {
+			return r
+		}
+	}
+	return nil
+}
+
+func newRelay(ids ...string) (*Relay, *memoryStore, *broker.Memory) {
+	now := time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC)
+	store := &memoryStore{now: now}
+	for _, id := range ids {
+		store.rows = append(store.rows, &row{event: Pending{Event: broker.Event{Id: id}}})
+	}
+	b := broker.NewMemory()
+	cfg := Config{BatchSize: 10, Lease: time.Minute, MaxAttempts: 3, Backoff: time.Second, MaxBackoff: 3 * time.Second}
+	relay := NewRelay(store, b, cfg, zap.NewNop())
+	relay.now = func() time.Time { return store.now }
+
+	return relay, store, b
+}
+
+func TestRelayPublishesInOrder(t *testing.T) {
+	relay, store, b := newRelay("e1", "e2", "e3")
+
+	claimed, err := relay.RelayBatch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claimed != 3 {
+		t.Errorf("expected 3 claimed events, got %d", claimed)
+	}
+
+	events := b.Events()
+	if len(events) != 3 || events[0].Id != "e1" || events[2].Id != "e3" {
+		t.Errorf("unexpected published events %+v", events)
+	}
+	for _, r := range store.rows {
+		if !r.published {
+			t.Errorf("expected %s to be marked published", r.event.Id)
+		}
+	}
+	if claimed, _ := relay.RelayBatch(context.Background()); claimed != 0 {
+		t.Errorf("expected published events not to be claimed again, got %d", claimed)
+	}
+}
+
+func TestRelaySkipsFailingEvents(t *testing.T) {
+	relay, store, b := newRelay("e1", "e2")
+	b.Fail = func(event broker.Event) error {
+		if event.Id == "e1" {
+			return errors.New("payload rejected")
+		}
+		return nil
+	}
+
+	if _, err := relay.RelayBatch(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if events := b.Events(); len(events) != 1 || events[0].Id != "e2" {
+		t.Errorf("expected e2 to be published past the failing e1, got %+v", events)
+	}
+
+	failed := store.rows[0]
+	if failed.published || failed.dead || failed.lastError != "payload rejected" {
+		t.Errorf("unexpected state of failed event %+v", failed)
+	}
+	if want := store.now.Add(time.Second); !failed.dueAt.Equal(want) {
+		t.Errorf("expected a retry at %v, got %v", want, failed.dueAt)
+	}
+	if claimed, _ := relay.RelayBatch(context.Background()); claimed != 0 {
+		t.Errorf("expected the failed event to wait for its backoff, got %d claimed", claimed)
+	}
+}
+
+func TestRelayGivesUpAfterMaxAttempts(t *testing.T) {
+	relay, store, b := newRelay("e1")
+	b.Fail = func(broker.Event) error { return errors.New("broker down") }
+
+	for i := 0; i < 3; i++ {
+		if claimed, err := relay.RelayBatch(context.Background()); err != nil || claimed != 1 {
+			t.Fatalf("attempt %d: claimed %d, err %v", i+1, claimed, err)
+		}
+		store.now = store.rows[0].dueAt
+	}
+
+	if !store.rows[0].dead {
+		t.Error("expected the event to be dead after 3 failures")
+	}
+	if claimed, _ := relay.RelayBatch(context.Background()); claimed != 0 {
+		t.Errorf("expected dead events not to be claimed, got %d", claimed)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	relay, _, _ := newRelay()
+
+	for attempts, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 3 * time.Second, 10: 3 * time.Second} {
+		if got := relay.backoff(attempts); got != want {
+			t.Errorf("backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package pricing
+
+import (
+	"fmt"
+
+	pbd "order_service/genproto/dish"
+	pb "order_service/genproto/order"
+	"order_service/pkg/money"
+)
+
+// Price checks every requested item against the menu of the kitchen and
+// returns the items with a snapshot of the dish name, unit price and line
+// total, together with the order total. dishes must be keyed by dish id and
+// only contain dishes that are not deleted.
+func Price(kitchenId string, items []*pb.Item, dishes map[string]*pbd.DishInfo) ([]*pb.Item, money.Money, error) {
+	if len(items) == 0 {
+		return nil, money.Money{}, fmt.Errorf("order must contain at least one item")
+	}
+
+	total := money.Money{}
+	priced := make([]*pb.Item, 0, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, money.Money{}, fmt.Errorf("quantity of dish %s must be positive", item.DishId)
+		}
+
+		dish, ok := dishes[item.DishId]
+		if !ok {
+			return nil, money.Money{}, fmt.Errorf("dish %s does not exist", item.DishId)
+		}
+		if dish.KitchenId != kitchenId {
+			return nil, money.Money{}, fmt.Errorf("dish %s does not belong to kitchen %s", item.DishId, kitchenId)
+		}
+		if !dish.Available {
+			return nil, money.Money{}, fmt.Errorf("dish %s is not available", item.DishId)
+		}
+
+		unitPrice := money.FromProto(dish.PriceMoney)
+		lineTotal := unitPrice.Mul(int64(item.Quantity))
+
+		var err error
+		total, err = total.Add(lineTotal)
+		if err != nil {
+			return nil, money.Money{}, fmt.Errorf("dish %s: %v", item.DishId, err)
+		}
+
+		priced = append(priced, &pb.Item{
+			DishId:         dish.Id,
+			Quantity:       item.Quantity,
+			Name:           dish.Name,
+			UnitPrice:      unitPrice.Float(),
+			LineTotal:      lineTotal.Float(),
+			UnitPriceMoney: &pb.Money{Amount: unitPrice.Amount, Currency: unitPrice.Currency},
+			LineTotalMoney: &pb.Money{Amount: lineTotal.Amount, Currency: lineTotal.Currency},
+		})
+	}
+
+	return priced, total, nil
+}
+
+func DishIds(items []*pb.Item) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.DishId)
+	}
+	return ids
+}
@@ -0,0 +1,50 @@
+package pricing
+
+import (
+	"testing"
+
+	pbd "order_service/genproto/dish"
+	pb "order_service/genproto/order"
+)
+
+func menu() map[string]*pbd.DishInfo {
+	return map[string]*pbd.DishInfo{
+		"plov":   {Id: "plov", KitchenId: "k1", Name: "Plov", PriceMoney: &pbd.Money{Amount: 1299, Currency: "UZS"}, Available: true},
+		"manti":  {Id: "manti", KitchenId: "k1", Name: "Manti", PriceMoney: &pbd.Money{Amount: 750, Currency: "UZS"}, Available: false},
+		"somsa":  {Id: "somsa", KitchenId: "k2", Name: "Somsa", PriceMoney: &pbd.Money{Amount: 300, Currency: "UZS"}, Available: true},
+		"burger": {Id: "burger", KitchenId: "k1", Name: "Burger", PriceMoney: &pbd.Money{Amount: 500, Currency: "USD"}, Available: true},
+	}
+}
+
+func TestPrice(t *testing.T) {
+	items, total, err := Price("k1", []*pb.Item{{DishId: "plov", Quantity: 3}}, menu())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total.Amount != 3897 || total.Currency != "UZS" {
+		t.Errorf("expected total 38.97 UZS, got %s %s", total, total.Currency)
+	}
+	if items[0].Name != "Plov" || items[0].UnitPriceMoney.Amount != 1299 || items[0].LineTotalMoney.Amount != 3897 {
+		t.Errorf("unexpected item snapshot %+v", items[0])
+	}
+	if items[0].LineTotal != 38.97 {
+		t.Errorf("expected legacy line total 38.97, got %v", items[0].LineTotal)
+	}
+}
+
+func TestPriceRejects(t *testing.T) {
+	cases := map[string][]*pb.Item{
+		"empty":       {},
+		"unknown":     {{DishId: "lagman", Quantity: 1}},
+		"foreign":     {{DishId: "somsa", Quantity: 1}},
+		"unavailable": {{DishId: "manti", Quantity: 1}},
+		"quantity":    {{DishId: "plov", Quantity: 0}},
+		"currency":    {{DishId: "plov", Quantity: 1}, {DishId: "burger", Quantity: 1}},
+	}
+
+	for name, items := range cases {
+		if _, _, err := Price("k1", items, menu()); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
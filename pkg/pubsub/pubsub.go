@@ -0,0 +1,68 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// Bus delivers the messages published on a channel to every current
+// subscriber of the channel. Delivery is best effort: subscribers that joined
+// later or fall behind miss messages.
+type Bus interface {
+	Publish(ctx context.Context, channel string, message []byte) error
+	// Subscribe returns the messages published on the channel until ctx is
+	// done, after which the returned channel is closed.
+	Subscribe(ctx context.Context, channel string) (<-chan []byte, error)
+}
+
+// bufferSize is the number of messages a slow subscriber of Memory may lag
+// behind before messages are dropped for it.
+const bufferSize = 64
+
+// Memory is an in-process Bus for tests and local runs.
+type Memory struct {
+	mu   sync.Mutex
+	subs map[string]map[chan []byte]struct{}
+}
+
+func NewMemory() *Memory {
+	return &Memory{subs: map[string]map[chan []byte]struct{}{}}
+}
+
+func (m *Memory) Publish(ctx context.Context, channel string, message []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for sub := range m.subs[channel] {
+		select {
+		case sub <- message:
+		default:
+		}
+	}
+	return nil
+}
+
+func (m *Memory) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	sub := make(chan []byte, bufferSize)
+
+	m.mu.Lock()
+	if m.subs[channel] == nil {
+		m.subs[channel] = map[chan []byte]struct{}{}
+	}
+	m.subs[channel][sub] = struct{}{}
+	m.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.subs[channel], sub)
+		if len(m.subs[channel]) == 0 {
+			delete(m.subs, channel)
+		}
+		close(sub)
+	}()
+
+	return sub, nil
+}
@@ -0,0 +1,94 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func receive(t *testing.T, sub <-chan []byte) string {
+	t.Helper()
+	select {
+	case message := <-sub:
+		return string(message)
+	case <-time.After(time.Second):
+		t.Fatal("no message received")
+		return ""
+	}
+}
+
+func TestMemory(t *testing.T) {
+	bus := NewMemory()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first, err := bus.Subscribe(ctx, "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := bus.Subscribe(ctx, "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := bus.Subscribe(ctx, "kitchens")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := bus.Publish(ctx, "orders", []byte("created")); err != nil {
+		t.Fatal(err)
+	}
+	if got := receive(t, first); got != "created" {
+		t.Errorf("first subscriber got %q", got)
+	}
+	if got := receive(t, second); got != "created" {
+		t.Errorf("second subscriber got %q", got)
+	}
+	select {
+	case message := <-other:
+		t.Errorf("subscriber of another channel got %q", message)
+	default:
+	}
+}
+
+func TestMemoryUnsubscribe(t *testing.T) {
+	bus := NewMemory()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sub, err := bus.Subscribe(ctx, "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	select {
+	case _, ok := <-sub:
+		if ok {
+			t.Error("expected the subscription to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("subscription not closed after cancel")
+	}
+	if err := bus.Publish(context.Background(), "orders", []byte("late")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemorySlowSubscriber(t *testing.T) {
+	bus := NewMemory()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub, err := bus.Subscribe(ctx, "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < bufferSize+10; i++ {
+		if err := bus.Publish(ctx, "orders", []byte("update")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(sub) != bufferSize {
+		t.Errorf("buffered %d messages, want %d", len(sub), bufferSize)
+	}
+}
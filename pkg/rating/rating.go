@@ -0,0 +1,15 @@
+package rating
+
+// PriorWeight is how many reviews at the global average every kitchen is
+// assumed to start with. Kitchens with fewer reviews than this are pulled
+// noticeably towards the global average.
+const PriorWeight = 10
+
+// Bayesian weighs the average of count reviews against the global average, so
+// that a couple of perfect reviews do not outrank a long track record.
+func Bayesian(average float64, count int, globalAverage float64, priorWeight int) float64 {
+	if count+priorWeight == 0 {
+		return 0
+	}
+	return (float64(priorWeight)*globalAverage + float64(count)*average) / float64(priorWeight+count)
+}
@@ -0,0 +1,25 @@
+package rating
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBayesian(t *testing.T) {
+	newKitchen := Bayesian(5, 2, 4, PriorWeight)
+	established := Bayesian(4.7, 300, 4, PriorWeight)
+	if newKitchen >= established {
+		t.Errorf("expected two 5 star reviews (%.3f) to rank below 300 reviews averaging 4.7 (%.3f)", newKitchen,
+			established)
+	}
+
+	if got := Bayesian(0, 0, 4.2, PriorWeight); got != 4.2 {
+		t.Errorf("expected a kitchen without reviews to get the global average, got %v", got)
+	}
+	if got := Bayesian(3, 10, 4, 10); math.Abs(got-3.5) > 1e-9 {
+		t.Errorf("expected 3.5, got %v", got)
+	}
+	if got := Bayesian(0, 0, 0, 0); got != 0 {
+		t.Errorf("expected 0 without reviews and prior, got %v", got)
+	}
+}
@@ -0,0 +1,115 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Point is a location on the map with an optional human readable address.
+type Point struct {
+	Address   string  `json:"address,omitempty"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Route is an estimate of a delivery trip. Distance is in kilometers.
+type Route struct {
+	Distance  float64
+	Duration  time.Duration
+	Polyline  string
+	Waypoints []Point
+}
+
+// RouteProvider estimates the route between two points. Implementations may
+// call an external maps API; Haversine works offline.
+type RouteProvider interface {
+	Route(ctx context.Context, from, to Point) (*Route, error)
+}
+
+func (p Point) Validate() error {
+	if p.Latitude < -90 || p.Latitude > 90 {
+		return fmt.Errorf("latitude %v is out of range", p.Latitude)
+	}
+	if p.Longitude < -180 || p.Longitude > 180 {
+		return fmt.Errorf("longitude %v is out of range", p.Longitude)
+	}
+	return nil
+}
+
+const earthRadius = 6371.0
+
+// Haversine estimates routes from the great-circle distance between two
+// points, stretched by DetourFactor to account for streets, and an average
+// courier Speed in km/h.
+type Haversine struct {
+	Speed        float64
+	DetourFactor float64
+}
+
+func NewHaversine() *Haversine {
+	return &Haversine{Speed: 25, DetourFactor: 1.3}
+}
+
+func (h *Haversine) Route(ctx context.Context, from, to Point) (*Route, error) {
+	if err := from.Validate(); err != nil {
+		return nil, err
+	}
+	if err := to.Validate(); err != nil {
+		return nil, err
+	}
+
+	distance := Distance(from, to) * h.DetourFactor
+	duration := time.Duration(distance / h.Speed * float64(time.Hour)).Round(time.Second)
+
+	return &Route{
+		Distance:  math.Round(distance*100) / 100,
+		Duration:  duration,
+		Polyline:  EncodePolyline([]Point{from, to}),
+		Waypoints: []Point{from, to},
+	}, nil
+}
+
+// Distance returns the great-circle distance between two points in km.
+func Distance(from, to Point) float64 {
+	lat1, lat2 := radians(from.Latitude), radians(to.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(to.Longitude - from.Longitude)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+// EncodePolyline encodes points with the Google encoded polyline algorithm
+// at a precision of five decimal places.
+func EncodePolyline(points []Point) string {
+	var b strings.Builder
+	var prevLat, prevLon int64
+	for _, p := range points {
+		lat := int64(math.Round(p.Latitude * 1e5))
+		lon := int64(math.Round(p.Longitude * 1e5))
+		encodeValue(&b, lat-prevLat)
+		encodeValue(&b, lon-prevLon)
+		prevLat, prevLon = lat, lon
+	}
+	return b.String()
+}
+
+func encodeValue(b *strings.Builder, v int64) {
+	v <<= 1
+	if v < 0 {
+		v = ^v
+	}
+	for v >= 0x20 {
+		b.WriteByte(byte((0x20 | (v & 0x1f)) + 63))
+		v >>= 5
+	}
+	b.WriteByte(byte(v + 63))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,53 @@
+package routing
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDistance(t *testing.T) {
+	// Amir Temur square to Chorsu bazaar in Tashkent.
+	from := Point{Latitude: 41.3111, Longitude: 69.2797}
+	to := Point{Latitude: 41.3265, Longitude: 69.2353}
+
+	d := Distance(from, to)
+	if math.Abs(d-4.06) > 0.05 {
+		t.Errorf("expected about 4.06 km, got %v", d)
+	}
+}
+
+func TestEncodePolyline(t *testing.T) {
+	points := []Point{
+		{Latitude: 38.5, Longitude: -120.2},
+		{Latitude: 40.7, Longitude: -120.95},
+		{Latitude: 43.252, Longitude: -126.453},
+	}
+
+	want := "_p~iF~ps|U_ulLnnqC_mqNvxq`@"
+	if got := EncodePolyline(points); got != want {
+		t.Errorf("EncodePolyline() = %q, want %q", got, want)
+	}
+}
+
+func TestHaversineRoute(t *testing.T) {
+	h := &Haversine{Speed: 30, DetourFactor: 1}
+	from := Point{Latitude: 0, Longitude: 0}
+	to := Point{Latitude: 0, Longitude: 0.26979}
+
+	route, err := h.Route(context.Background(), from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(route.Distance-30) > 0.01 {
+		t.Errorf("expected 30 km, got %v", route.Distance)
+	}
+	if route.Duration < 59*time.Minute || route.Duration > 61*time.Minute {
+		t.Errorf("expected about an hour, got %v", route.Duration)
+	}
+
+	if _, err := h.Route(context.Background(), Point{Latitude: 91}, to); err == nil {
+		t.Error("expected invalid latitude to fail")
+	}
+}
@@ -0,0 +1,19 @@
+package scheduling
+
+import "time"
+
+// Slot is a delivery window scheduled orders are booked into. Start is
+// inclusive and End exclusive.
+type Slot struct {
+	Start time.Time
+	End   time.Time
+}
+
+// SlotAt returns the slot of the given size containing t. Slots are counted
+// from midnight in the location of t, so a size that divides a day gives the
+// same slots every day.
+func SlotAt(t time.Time, size time.Duration) Slot {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	start := midnight.Add(t.Sub(midnight) / size * size)
+	return Slot{Start: start, End: start.Add(size)}
+}
@@ -0,0 +1,36 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSlotAt(t *testing.T) {
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		at         time.Time
+		size       time.Duration
+		start, end string
+	}{
+		{time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC), 30 * time.Minute, "12:00", "12:30"},
+		{time.Date(2024, 3, 1, 12, 29, 59, 0, time.UTC), 30 * time.Minute, "12:00", "12:30"},
+		{time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC), 30 * time.Minute, "12:30", "13:00"},
+		{time.Date(2024, 3, 1, 23, 50, 0, 0, time.UTC), time.Hour, "23:00", "00:00"},
+		// Slots follow the local clock in zones with a half hour offset.
+		{time.Date(2024, 3, 1, 12, 40, 0, 0, kolkata), time.Hour, "12:00", "13:00"},
+	}
+	for _, c := range cases {
+		slot := SlotAt(c.at, c.size)
+		start, end := slot.Start.Format("15:04"), slot.End.Format("15:04")
+		if start != c.start || end != c.end {
+			t.Errorf("SlotAt(%s, %s) = %s-%s, want %s-%s", c.at, c.size, start, end, c.start, c.end)
+		}
+		if slot.Start.Location() != c.at.Location() {
+			t.Errorf("SlotAt(%s) changed the location to %s", c.at, slot.Start.Location())
+		}
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"errors"
 	"net/mail"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 	"unicode"
 )
 
@@ -68,3 +71,99 @@ func ValidatePhoneNumber(number string) error {
 
 	return nil
 }
+
+// ValidateCardNumber checks the length and the Luhn checksum of a card number.
+func ValidateCardNumber(number string) error {
+	if len(number) < 12 || len(number) > 19 {
+		return errors.New("card number must have 12 to 19 digits")
+	}
+
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		if number[i] < '0' || number[i] > '9' {
+			return errors.New("card number must contain digits only")
+		}
+		digit := int(number[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+
+	if sum%10 != 0 {
+		return errors.New("invalid card number")
+	}
+
+	return nil
+}
+
+// ValidateExpiryDate accepts MM/YY or MM/YYYY. A card is valid until the end
+// of its expiry month.
+func ValidateExpiryDate(expiry string, now time.Time) error {
+	month, year, ok := strings.Cut(expiry, "/")
+	if !ok {
+		return errors.New("expiry date must be in MM/YY format")
+	}
+
+	m, err := strconv.Atoi(month)
+	if err != nil || m < 1 || m > 12 {
+		return errors.New("invalid expiry month")
+	}
+	y, err := strconv.Atoi(year)
+	if err != nil || (len(year) != 2 && len(year) != 4) {
+		return errors.New("invalid expiry year")
+	}
+	if len(year) == 2 {
+		y += 2000
+	}
+
+	endOfMonth := time.Date(y, time.Month(m)+1, 1, 0, 0, 0, 0, time.UTC)
+	if !now.Before(endOfMonth) {
+		return errors.New("card has expired")
+	}
+
+	return nil
+}
+
+func ValidateCvv(cvv string) error {
+	regex := regexp.MustCompile(`^\d{3,4}$`)
+
+	if !regex.MatchString(cvv) {
+		return errors.New("cvv must have 3 or 4 digits")
+	}
+
+	return nil
+}
+
+// ValidateRating checks that a review rating is between 1 and 5 stars.
+func ValidateRating(rating int32) error {
+	if rating < 1 || rating > 5 {
+		return errors.New("rating must be between 1 and 5")
+	}
+	return nil
+}
+
+// ValidatePrice checks that a price in minor units is not negative. Free
+// items are allowed.
+func ValidatePrice(amount int64) error {
+	if amount < 0 {
+		return errors.New("price must not be negative")
+	}
+	return nil
+}
+
+// ValidateDate accepts a date as YYYY-MM-DD or an RFC 3339 timestamp.
+func ValidateDate(date string) error {
+	if _, err := time.Parse(time.DateOnly, date); err == nil {
+		return nil
+	}
+	if _, err := time.Parse(time.RFC3339, date); err == nil {
+		return nil
+	}
+	return errors.New("date must be in YYYY-MM-DD or RFC 3339 format")
+}
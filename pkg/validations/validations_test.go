@@ -0,0 +1,80 @@
+package validations
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateCardNumber(t *testing.T) {
+	for _, number := range []string{"4242424242424242", "5555555555554444", "378282246310005", "8600312998426023"} {
+		if err := ValidateCardNumber(number); err != nil {
+			t.Errorf("%s: %v", number, err)
+		}
+	}
+	for _, number := range []string{"4242424242424241", "4242-4242-4242-4242", "1234", ""} {
+		if err := ValidateCardNumber(number); err == nil {
+			t.Errorf("%s: expected an error", number)
+		}
+	}
+}
+
+func TestValidateExpiryDate(t *testing.T) {
+	now := time.Date(2024, time.July, 17, 12, 0, 0, 0, time.UTC)
+
+	for _, expiry := range []string{"07/24", "12/2030", "01/25"} {
+		if err := ValidateExpiryDate(expiry, now); err != nil {
+			t.Errorf("%s: %v", expiry, err)
+		}
+	}
+	for _, expiry := range []string{"06/24", "13/25", "0725", "07/5", "aa/bb"} {
+		if err := ValidateExpiryDate(expiry, now); err == nil {
+			t.Errorf("%s: expected an error", expiry)
+		}
+	}
+}
+
+func TestValidateCvv(t *testing.T) {
+	if err := ValidateCvv("123"); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateCvv("12a"); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestValidateRating(t *testing.T) {
+	for _, rating := range []int32{1, 3, 5} {
+		if err := ValidateRating(rating); err != nil {
+			t.Errorf("%d: %v", rating, err)
+		}
+	}
+	for _, rating := range []int32{0, 6, -1} {
+		if err := ValidateRating(rating); err == nil {
+			t.Errorf("%d: expected an error", rating)
+		}
+	}
+}
+
+func TestValidatePrice(t *testing.T) {
+	for _, amount := range []int64{0, 1, 1500000} {
+		if err := ValidatePrice(amount); err != nil {
+			t.Errorf("%d: %v", amount, err)
+		}
+	}
+	if err := ValidatePrice(-1); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestValidateDate(t *testing.T) {
+	for _, date := range []string{"2024-02-29", "2024-03-01T10:00:00Z", "2024-03-01T10:00:00+05:00"} {
+		if err := ValidateDate(date); err != nil {
+			t.Errorf("%s: %v", date, err)
+		}
+	}
+	for _, date := range []string{"", "2023-02-29", "01/03/2024", "2024-03-01 10:00"} {
+		if err := ValidateDate(date); err == nil {
+			t.Errorf("%q: expected an error", date)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package vault
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+)
+
+var ErrUnknownToken = errors.New("unknown card token")
+
+// Card is the sensitive part of a payment card. The CVV is deliberately not
+// part of it: it is only ever passed straight to the gateway.
+type Card struct {
+	Number     string `json:"number"`
+	ExpiryDate string `json:"expiry_date"`
+}
+
+// Token is what the service is allowed to keep in place of a card.
+type Token struct {
+	Token string
+	Brand string
+	Last4 string
+}
+
+// Vault swaps card numbers for opaque tokens.
+type Vault interface {
+	Tokenize(ctx context.Context, card Card) (*Token, error)
+	Detokenize(ctx context.Context, token string) (*Card, error)
+}
+
+// Local is an in-process Vault that keeps cards encrypted with AES-GCM in
+// memory, so card numbers never reach the database or the logs. Tokens live
+// for the lifetime of the process.
+type Local struct {
+	mu    sync.RWMutex
+	aead  cipher.AEAD
+	cards map[string][]byte
+}
+
+func NewLocal(secret string) (*Local, error) {
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Local{aead: aead, cards: map[string][]byte{}}, nil
+}
+
+func (l *Local) Tokenize(ctx context.Context, card Card) (*Token, error) {
+	plain, err := json.Marshal(card)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, l.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	token := "tok_" + hex.EncodeToString(id)
+
+	l.mu.Lock()
+	l.cards[token] = l.aead.Seal(nonce, nonce, plain, []byte(token))
+	l.mu.Unlock()
+
+	return &Token{Token: token, Brand: Brand(card.Number), Last4: last4(card.Number)}, nil
+}
+
+func (l *Local) Detokenize(ctx context.Context, token string) (*Card, error) {
+	l.mu.RLock()
+	sealed, ok := l.cards[token]
+	l.mu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownToken
+	}
+
+	size := l.aead.NonceSize()
+	plain, err := l.aead.Open(nil, sealed[:size], sealed[size:], []byte(token))
+	if err != nil {
+		return nil, err
+	}
+
+	card := &Card{}
+	if err := json.Unmarshal(plain, card); err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+// Brand guesses the card scheme from the leading digits of the number.
+func Brand(number string) string {
+	switch {
+	case strings.HasPrefix(number, "8600"):
+		return "uzcard"
+	case strings.HasPrefix(number, "9860"):
+		return "humo"
+	case strings.HasPrefix(number, "4"):
+		return "visa"
+	case strings.HasPrefix(number, "34"), strings.HasPrefix(number, "37"):
+		return "amex"
+	case strings.HasPrefix(number, "6011"), strings.HasPrefix(number, "65"):
+		return "discover"
+	case len(number) >= 2 && number[0] == '5' && number[1] >= '1' && number[1] <= '5':
+		return "mastercard"
+	case len(number) >= 4 && number[:4] >= "2221" && number[:4] <= "2720":
+		return "mastercard"
+	}
+	return "unknown"
+}
+
+func last4(number string) string {
+	if len(number) < 4 {
+		return number
+	}
+	return number[len(number)-4:]
+}
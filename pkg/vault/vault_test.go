@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestLocalVault(t *testing.T) {
+	ctx := context.Background()
+	v, err := NewLocal("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	card := Card{Number: "4242424242424242", ExpiryDate: "12/30"}
+	token, err := v.Tokenize(ctx, card)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Brand != "visa" || token.Last4 != "4242" {
+		t.Errorf("unexpected token %+v", token)
+	}
+	if strings.Contains(token.Token, card.Number) {
+		t.Error("token must not contain the card number")
+	}
+
+	got, err := v.Detokenize(ctx, token.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != card {
+		t.Errorf("Detokenize() = %+v, want %+v", got, card)
+	}
+
+	if _, err := v.Detokenize(ctx, "tok_unknown"); err != ErrUnknownToken {
+		t.Errorf("expected ErrUnknownToken, got %v", err)
+	}
+}
+
+func TestBrand(t *testing.T) {
+	cases := map[string]string{
+		"4242424242424242": "visa",
+		"5555555555554444": "mastercard",
+		"2223003122003222": "mastercard",
+		"378282246310005":  "amex",
+		"8600312998426023": "uzcard",
+		"9860000000000000": "humo",
+		"1234567890123":    "unknown",
+	}
+
+	for number, want := range cases {
+		if got := Brand(number); got != want {
+			t.Errorf("Brand(%s) = %s, want %s", number, got, want)
+		}
+	}
+}
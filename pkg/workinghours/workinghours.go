@@ -0,0 +1,128 @@
+package workinghours
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	clockLayout = "15:04"
+	dateLayout  = "2006-01-02"
+)
+
+// Period is a span of a day given as offsets from midnight. Close may be
+// before Open, in which case the period runs past midnight into the next day.
+type Period struct {
+	Open  time.Duration
+	Close time.Duration
+}
+
+// Override replaces the weekly hours on a single date. When RepeatsYearly is
+// set only the month and day of Date are compared, which is used for public
+// holidays.
+type Override struct {
+	Date          time.Time
+	Closed        bool
+	Period        Period
+	RepeatsYearly bool
+}
+
+// Schedule holds the weekly working hours of a kitchen and its overrides.
+// Days without an entry are days off.
+type Schedule struct {
+	Days      map[time.Weekday]Period
+	Overrides []Override
+}
+
+// ParseClock parses a time of day such as "09:00" or "09:00:00".
+func ParseClock(clock string) (time.Duration, error) {
+	if len(clock) > len(clockLayout) {
+		clock = clock[:len(clockLayout)]
+	}
+	t, err := time.Parse(clockLayout, clock)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", clock)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func ParsePeriod(open, close string) (Period, error) {
+	o, err := ParseClock(open)
+	if err != nil {
+		return Period{}, err
+	}
+	c, err := ParseClock(close)
+	if err != nil {
+		return Period{}, err
+	}
+	if o == c {
+		return Period{}, fmt.Errorf("open and close times must differ")
+	}
+
+	return Period{Open: o, Close: c}, nil
+}
+
+func ParseDate(date string) (time.Time, error) {
+	t, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+	}
+	return t, nil
+}
+
+func FormatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+// IsEmpty reports whether no weekly hours and no overrides were configured.
+func (s Schedule) IsEmpty() bool {
+	return len(s.Days) == 0 && len(s.Overrides) == 0
+}
+
+// IsOpen reports whether the kitchen is open at t. t must already be in the
+// time zone of the kitchen.
+func (s Schedule) IsOpen(t time.Time) bool {
+	today := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	// A period that started yesterday may still be running after midnight.
+	for _, day := range []time.Time{today, today.AddDate(0, 0, -1)} {
+		period, ok := s.periodOn(day)
+		if !ok {
+			continue
+		}
+
+		start := day.Add(period.Open)
+		end := day.Add(period.Close)
+		if period.Close <= period.Open {
+			end = end.Add(24 * time.Hour)
+		}
+		if !t.Before(start) && t.Before(end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s Schedule) periodOn(day time.Time) (Period, bool) {
+	for _, o := range s.Overrides {
+		if !o.matches(day) {
+			continue
+		}
+		if o.Closed {
+			return Period{}, false
+		}
+		return o.Period, true
+	}
+
+	period, ok := s.Days[day.Weekday()]
+	return period, ok
+}
+
+func (o Override) matches(day time.Time) bool {
+	if o.RepeatsYearly {
+		return o.Date.Month() == day.Month() && o.Date.Day() == day.Day()
+	}
+	return o.Date.Year() == day.Year() && o.Date.Month() == day.Month() && o.Date.Day() == day.Day()
+}
@@ -0,0 +1,77 @@
+package workinghours
+
+import (
+	"testing"
+	"time"
+)
+
+func at(date, clock string) time.Time {
+	t, err := time.Parse("2006-01-02 15:04", date+" "+clock)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestIsOpen(t *testing.T) {
+	day, _ := ParsePeriod("09:00", "18:00")
+	night, _ := ParsePeriod("18:00", "02:00")
+	newYear, _ := ParseDate("2024-01-01")
+	closure, _ := ParseDate("2024-07-17")
+	short, _ := ParsePeriod("10:00", "12:00")
+	shortDay, _ := ParseDate("2024-07-18")
+
+	s := Schedule{
+		Days: map[time.Weekday]Period{
+			time.Monday:    day,
+			time.Tuesday:   day,
+			time.Wednesday: day,
+			time.Thursday:  day,
+			time.Friday:    night,
+		},
+		Overrides: []Override{
+			{Date: newYear, Closed: true, RepeatsYearly: true},
+			{Date: closure, Closed: true},
+			{Date: shortDay, Period: short},
+		},
+	}
+
+	cases := []struct {
+		at   time.Time
+		want bool
+	}{
+		{at("2024-07-15", "09:00"), true},  // Monday opening
+		{at("2024-07-15", "18:00"), false}, // Monday closing
+		{at("2024-07-15", "08:59"), false},
+		{at("2024-07-19", "23:30"), true},  // Friday night
+		{at("2024-07-20", "01:30"), true},  // spills into Saturday
+		{at("2024-07-20", "02:00"), false}, // Saturday is otherwise off
+		{at("2024-07-21", "12:00"), false}, // Sunday
+		{at("2024-07-17", "12:00"), false}, // one-off closure
+		{at("2024-07-18", "11:00"), true},  // special hours
+		{at("2024-07-18", "13:00"), false},
+		{at("2029-01-01", "12:00"), false}, // yearly holiday
+	}
+
+	for _, c := range cases {
+		if got := s.IsOpen(c.at); got != c.want {
+			t.Errorf("IsOpen(%s) = %v, want %v", c.at, got, c.want)
+		}
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	if _, err := ParsePeriod("9am", "18:00"); err == nil {
+		t.Error("expected invalid open time to fail")
+	}
+	if _, err := ParsePeriod("10:00", "10:00"); err == nil {
+		t.Error("expected empty period to fail")
+	}
+	p, err := ParsePeriod("09:30:00", "18:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if FormatClock(p.Open) != "09:30" || FormatClock(p.Close) != "18:00" {
+		t.Errorf("unexpected period %v", p)
+	}
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"order_service/models"
+	"order_service/pkg/auth"
+	"order_service/pkg/connections"
+
+	pbk "order_service/genproto/kitchen"
+	pbo "order_service/genproto/order"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	customers = []string{auth.RoleCustomer}
+	chefs     = []string{auth.RoleChef}
+	admins    = []string{auth.RoleAdmin}
+)
+
+// accessRules maps every RPC of the service to its access rule. RPCs missing
+// here are denied by the interceptor.
+var accessRules = map[string]auth.Rule{
+	"/order.Order/CreateOrder":                {Roles: customers, Self: userId},
+	"/order.Order/UpdateOrderStatus":          {Roles: chefs},
+	"/order.Order/GetOrderById":               {},
+	"/order.Order/GetOrdersForUser":           {Roles: customers, Self: id},
+	"/order.Order/GetOrdersForChef":           {Roles: chefs, Kitchen: id},
+	"/order.Order/DeleteOrder":                {Roles: customers},
+	"/order.Order/CancelOrder":                {},
+	"/order.Order/WatchOrder":                 {},
+	"/order.Order/WatchKitchenOrders":         {Roles: chefs, Kitchen: id},
+	"/order.Order/ValidateOrderId":            {},
+	"/order.Order/GetKitchenStatistics":       {Roles: chefs, Kitchen: id},
+	"/order.Order/GetUserStatistics":          {Roles: customers, Self: id},
+	"/order.Order/ManageWorkingHours":         {},
+	"/order.Order/CreateWorkingHours":         {Roles: chefs, Kitchen: kitchenId},
+	"/order.Order/UpdateWorkingHours":         {Roles: chefs, Kitchen: kitchenId},
+	"/order.Order/DeleteWorkingHours":         {Roles: chefs, Kitchen: id},
+	"/order.Order/CreateWorkingHoursOverride": {Roles: chefs, Kitchen: kitchenId},
+	"/order.Order/GetWorkingHoursOverrides":   {},
+	"/order.Order/DeleteWorkingHoursOverride": {Roles: chefs},
+
+	"/dish.Dish/CreateDish":          {Roles: chefs, Kitchen: kitchenId},
+	"/dish.Dish/UpdateDish":          {Roles: chefs},
+	"/dish.Dish/GetDishes":           {},
+	"/dish.Dish/GetDishById":         {},
+	"/dish.Dish/DeleteDish":          {Roles: chefs},
+	"/dish.Dish/ValidateDishId":      {},
+	"/dish.Dish/UpdateNutritionInfo": {Roles: chefs},
+	"/dish.Dish/RecommendDishes":     {},
+
+	"/payment.Payment/CreatePayment":     {Roles: customers},
+	"/payment.Payment/ValidatePaymentId": {},
+	"/payment.Payment/RefundPayment":     {Roles: admins},
+
+	"/review.Review/CreateReview":          {Roles: customers, Self: userId},
+	"/review.Review/GetReviewsByKitchenId": {},
+	"/review.Review/DeleteComment":         {Roles: customers},
+	"/review.Review/ValidateReviewId":      {},
+	"/review.Review/UpdateReview":          {Roles: customers},
+	"/review.Review/ReplyToReview":         {Roles: chefs},
+	"/review.Review/FlagReview":            {},
+	"/review.Review/ModerateReview":        {Roles: admins},
+	"/review.Review/GetRatingStats":        {},
+
+	"/delivery.DeliveryRoute/CreateRoute":       {Roles: chefs},
+	"/delivery.DeliveryRoute/UpdateRoute":       {Roles: chefs},
+	"/delivery.DeliveryRoute/GetRouteByOrderId": {},
+}
+
+// NewAuthInterceptor authenticates callers with the access token secret shared
+// with the auth service and applies accessRules.
+func NewAuthInterceptor(sysConfig *models.SystemConfig) grpc.UnaryServerInterceptor {
+	owner := kitchenOwner(connections.NewKitchenService(sysConfig))
+	return auth.UnaryServerInterceptor(auth.NewJWTVerifier(sysConfig.Config.ACCESS_TOKEN_SECRET), accessRules, owner)
+}
+
+// NewStreamAuthInterceptor applies accessRules to streaming RPCs.
+func NewStreamAuthInterceptor(sysConfig *models.SystemConfig) grpc.StreamServerInterceptor {
+	owner := kitchenOwner(connections.NewKitchenService(sysConfig))
+	return auth.StreamServerInterceptor(auth.NewJWTVerifier(sysConfig.Config.ACCESS_TOKEN_SECRET), accessRules, owner)
+}
+
+func kitchenOwner(kitchenClient pbk.KitchenClient) auth.KitchenOwner {
+	return func(ctx context.Context, kitchenId string) (string, error) {
+		kitchen, err := kitchenClient.GetKitchenById(ctx, &pbk.Id{Id: kitchenId})
+		if err != nil {
+			return "", err
+		}
+		return kitchen.OwnerId, nil
+	}
+}
+
+// authorizeUser lets admins and the user owning a resource through.
+func authorizeUser(ctx context.Context, ownerId string) error {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "access token is required")
+	}
+	if principal.IsAdmin() || principal.UserId == ownerId {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "resource belongs to another user")
+}
+
+// authorizeKitchen lets admins and the owner of the kitchen through.
+func authorizeKitchen(ctx context.Context, kitchenClient pbk.KitchenClient, kitchenId string) error {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "access token is required")
+	}
+	if principal.IsAdmin() {
+		return nil
+	}
+
+	ownerId, err := kitchenOwner(kitchenClient)(ctx, kitchenId)
+	if err != nil {
+		return err
+	}
+	if principal.UserId != ownerId {
+		return status.Error(codes.PermissionDenied, "resource belongs to another kitchen")
+	}
+	return nil
+}
+
+// authorizeOrder lets admins, the customer of an order and the chef of its
+// kitchen through.
+func authorizeOrder(ctx context.Context, kitchenClient pbk.KitchenClient, order *pbo.OrderInfo) error {
+	if err := authorizeUser(ctx, order.UserId); status.Code(err) != codes.PermissionDenied {
+		return err
+	}
+	return authorizeKitchen(ctx, kitchenClient, order.KitchenId)
+}
+
+func id(req any) string {
+	if r, ok := req.(interface{ GetId() string }); ok {
+		return r.GetId()
+	}
+	return ""
+}
+
+func userId(req any) string {
+	if r, ok := req.(interface{ GetUserId() string }); ok {
+		return r.GetUserId()
+	}
+	return ""
+}
+
+func kitchenId(req any) string {
+	if r, ok := req.(interface{ GetKitchenId() string }); ok {
+		return r.GetKitchenId()
+	}
+	return ""
+}
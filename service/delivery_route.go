@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"order_service/models"
+	"order_service/pkg/connections"
+	"order_service/pkg/eta"
+	"order_service/pkg/routing"
+	"order_service/storage/postgres"
+
+	pb "order_service/genproto/delivery"
+	pbk "order_service/genproto/kitchen"
+	pbo "order_service/genproto/order"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeliveryRouteService struct {
+	routeRepo     *postgres.DeliveryRouteRepo
+	orderRepo     *postgres.OrderRepo
+	kitchenClient pbk.KitchenClient
+	provider      routing.RouteProvider
+	estimator     eta.Estimator
+	events        *orderEvents
+	log           *zap.Logger
+	pb.UnimplementedDeliveryRouteServer
+}
+
+func NewDeliveryRouteService(sysConfig *models.SystemConfig, provider routing.RouteProvider) *DeliveryRouteService {
+	return &DeliveryRouteService{
+		routeRepo:     postgres.NewDeliveryRouteRepo(sysConfig.PostgresDb),
+		orderRepo:     postgres.NewOrderRepo(sysConfig.PostgresDb),
+		kitchenClient: connections.NewKitchenService(sysConfig),
+		provider:      provider,
+		estimator:     newEstimator(sysConfig),
+		events:        newOrderEvents(sysConfig),
+		log:           sysConfig.Logger,
+	}
+}
+
+func (d *DeliveryRouteService) CreateRoute(ctx context.Context, req *pb.ReqRoute) (*pb.RouteInfo, error) {
+	order, route, err := d.estimate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.routeRepo.CreateRoute(ctx, req, route)
+	if err == postgres.ErrRouteExists {
+		return nil, status.Errorf(codes.AlreadyExists, "order %s already has a delivery route", req.OrderId)
+	}
+	if err != nil {
+		d.log.Error("failed to create delivery route ", zap.Error(err))
+		return nil, err
+	}
+	estimate := refreshEta(ctx, d.orderRepo, d.estimator, req.OrderId, d.log)
+	d.events.publish(ctx, models.OrderEventEtaUpdated, order, order.Status, estimate)
+	if res.DeliveryTime == "" {
+		res.DeliveryTime = estimate
+	}
+
+	return res, nil
+}
+
+func (d *DeliveryRouteService) UpdateRoute(ctx context.Context, req *pb.ReqRoute) (*pb.RouteInfo, error) {
+	order, route, err := d.estimate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.routeRepo.UpdateRoute(ctx, req, route)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "order %s has no delivery route", req.OrderId)
+	}
+	if err != nil {
+		d.log.Error("failed to update delivery route ", zap.Error(err))
+		return nil, err
+	}
+	estimate := refreshEta(ctx, d.orderRepo, d.estimator, req.OrderId, d.log)
+	d.events.publish(ctx, models.OrderEventEtaUpdated, order, order.Status, estimate)
+	if res.DeliveryTime == "" {
+		res.DeliveryTime = estimate
+	}
+
+	return res, nil
+}
+
+func (d *DeliveryRouteService) GetRouteByOrderId(ctx context.Context, id *pb.Id) (*pb.RouteInfo, error) {
+	order, err := d.orderRepo.GetOrderById(ctx, id.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "order %s not found", id.Id)
+	}
+	if err != nil {
+		d.log.Error("failed to get order for delivery route ", zap.Error(err))
+		return nil, err
+	}
+	if err := authorizeOrder(ctx, d.kitchenClient, order); err != nil {
+		return nil, err
+	}
+
+	res, err := d.routeRepo.GetRouteByOrderId(ctx, id.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "order %s has no delivery route", id.Id)
+	}
+	if err != nil {
+		d.log.Error("failed to get delivery route ", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// estimate checks the route request and asks the provider for the route. It
+// returns the order the route is for as well.
+func (d *DeliveryRouteService) estimate(ctx context.Context, req *pb.ReqRoute) (*pbo.OrderInfo, *routing.Route, error) {
+	order, err := d.orderRepo.GetOrderById(ctx, req.OrderId)
+	if err == sql.ErrNoRows {
+		return nil, nil, status.Errorf(codes.NotFound, "order %s not found", req.OrderId)
+	}
+	if err != nil {
+		d.log.Error("failed to get order for delivery route ", zap.Error(err))
+		return nil, nil, err
+	}
+	if err := authorizeKitchen(ctx, d.kitchenClient, order.KitchenId); err != nil {
+		return nil, nil, err
+	}
+	if req.Start == nil || req.End == nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "start and end locations are required")
+	}
+
+	from := routing.Point{Address: req.StartAddress, Latitude: req.Start.Latitude, Longitude: req.Start.Longitude}
+	to := routing.Point{Address: req.EndAddress, Latitude: req.End.Latitude, Longitude: req.End.Longitude}
+	if err := from.Validate(); err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := to.Validate(); err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	route, err := d.provider.Route(ctx, from, to)
+	if err != nil {
+		d.log.Error("failed to estimate delivery route ", zap.Error(err))
+		return nil, nil, err
+	}
+
+	return order, route, nil
+}
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"order_service/models"
 	"order_service/pkg/connections"
+	"order_service/pkg/money"
+	"order_service/pkg/validations"
 	"order_service/storage/postgres"
 
 	pb "order_service/genproto/dish"
@@ -11,21 +14,33 @@ import (
 	pbu "order_service/genproto/user"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// defaultPrepTimeMinutes is the prep time of dishes created without one.
+const defaultPrepTimeMinutes = 15
+
 type DishService struct {
 	dishRepo      *postgres.DishRepo
 	kitchenClient pbk.KitchenClient
 	userClient    pbu.UserServiceClient
+	lookups       *lookups
+	currency      string
 	log           *zap.Logger
 	pb.UnimplementedDishServer
 }
 
 func NewDishService(sysConfig *models.SystemConfig) *DishService {
+	kitchenClient := connections.NewKitchenService(sysConfig)
+	userClient := connections.NewUserService(sysConfig)
+
 	return &DishService{
 		dishRepo:      postgres.NewDishRepo(sysConfig.PostgresDb),
-		kitchenClient: connections.NewKitchenService(sysConfig),
-		userClient:    connections.NewUserService(sysConfig),
+		kitchenClient: kitchenClient,
+		userClient:    userClient,
+		lookups:       newLookups(sysConfig, kitchenClient, userClient),
+		currency:      sysConfig.Config.CURRENCY,
 		log:           sysConfig.Logger,
 	}
 }
@@ -36,7 +51,18 @@ func (d *DishService) CreateDish(ctx context.Context, dish *pb.ReqCreateDish) (*
 		d.log.Info("Invalid kitchen Id ", zap.Error(err))
 		return nil, err
 	}
-	res, err := d.dishRepo.CreateDish(ctx, dish)
+	if dish.PrepTimeMinutes < 0 {
+		return nil, status.Error(codes.InvalidArgument, "prep time must be positive")
+	}
+	if dish.PrepTimeMinutes == 0 {
+		dish.PrepTimeMinutes = defaultPrepTimeMinutes
+	}
+	price := d.price(dish.PriceMoney, dish.Price)
+	if err := validations.ValidatePrice(price.Amount); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := d.dishRepo.CreateDish(ctx, dish, price)
 	if err != nil {
 		d.log.Error("failed to create dish ", zap.Error(err))
 		return nil, err
@@ -46,7 +72,20 @@ func (d *DishService) CreateDish(ctx context.Context, dish *pb.ReqCreateDish) (*
 }
 
 func (d *DishService) UpdateDish(ctx context.Context, dish *pb.ReqUpdateDish) (*pb.DishInfo, error) {
-	res, err := d.dishRepo.UpdateDish(ctx, dish)
+	if err := d.authorizeDish(ctx, dish.Id); err != nil {
+		return nil, err
+	}
+
+	// A zero prep time keeps the current one.
+	if dish.PrepTimeMinutes < 0 {
+		return nil, status.Error(codes.InvalidArgument, "prep time must be positive")
+	}
+	price := d.price(dish.PriceMoney, dish.Price)
+	if err := validations.ValidatePrice(price.Amount); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := d.dishRepo.UpdateDish(ctx, dish, price)
 	if err != nil {
 		d.log.Error("failed to update dish ", zap.Error(err))
 		return nil, err
@@ -76,13 +115,16 @@ func (d *DishService) GetDishes(ctx context.Context, filter *pb.Pagination) (*pb
 		return nil, err
 	}
 
-	for i := 0; i < len(res.Dishes); i++ {
-		kitchen, err := d.kitchenClient.GetKitchenById(ctx, &pbk.Id{Id: res.Dishes[i].KitchenId})
-		if err != nil {
-			d.log.Error("failed to get kitchen by Id for dish ", zap.Error(err))
-			return nil, err
-		}
-		res.Dishes[i].KitchenName = kitchen.Name
+	kitchenIds := make([]string, 0, len(res.Dishes))
+	for _, dish := range res.Dishes {
+		kitchenIds = append(kitchenIds, dish.KitchenId)
+	}
+	kitchens, err := d.lookups.kitchens.Get(ctx, kitchenIds)
+	if err != nil {
+		d.log.Error("failed to get kitchens of dishes ", zap.Error(err))
+	}
+	for _, dish := range res.Dishes {
+		dish.KitchenName = kitchens[dish.KitchenId].Name
 	}
 
 	return res, nil
@@ -106,6 +148,10 @@ func (d *DishService) GetDishById(ctx context.Context, id *pb.Id) (*pb.DishInfo,
 }
 
 func (d *DishService) DeleteDish(ctx context.Context, id *pb.Id) (*pb.Void, error) {
+	if err := d.authorizeDish(ctx, id.Id); err != nil {
+		return nil, err
+	}
+
 	err := d.dishRepo.DeleteDish(ctx, id.Id)
 	if err != nil {
 		d.log.Error("failed to delete dish by id ", zap.Error(err))
@@ -126,6 +172,10 @@ func (d *DishService) ValidateDishId(ctx context.Context, id *pb.Id) (*pb.Void,
 }
 
 func (d *DishService) UpdateNutritionInfo(ctx context.Context, info *pb.NutritionInfo) (*pb.DishInfo, error) {
+	if err := d.authorizeDish(ctx, info.Id); err != nil {
+		return nil, err
+	}
+
 	dish, err := d.dishRepo.UpdateNutritionInfo(ctx, info)
 	if err != nil {
 		d.log.Info("failed to update NutritionInfo ", zap.Error(err))
@@ -164,3 +214,31 @@ func (d *DishService) RecommendDishes(ctx context.Context, filter *pb.Filter) (*
 
 	return res, nil
 }
+
+// price prefers the exact price of a request and falls back to the legacy
+// float price in the configured currency.
+func (d *DishService) price(exact *pb.Money, legacy float32) money.Money {
+	if exact != nil {
+		price := money.FromProto(exact)
+		if price.Currency == "" {
+			price.Currency = d.currency
+		}
+		return price
+	}
+
+	return money.FromFloat(float64(legacy), d.currency)
+}
+
+// authorizeDish checks that the caller owns the kitchen of the dish.
+func (d *DishService) authorizeDish(ctx context.Context, id string) error {
+	kitchenId, err := d.dishRepo.GetDishKitchenId(ctx, id)
+	if err == sql.ErrNoRows {
+		return status.Errorf(codes.NotFound, "dish %s not found", id)
+	}
+	if err != nil {
+		d.log.Error("failed to get kitchen of dish ", zap.Error(err))
+		return err
+	}
+
+	return authorizeKitchen(ctx, d.kitchenClient, kitchenId)
+}
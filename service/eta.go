@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"order_service/models"
+	"order_service/pkg/eta"
+	"order_service/storage/postgres"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func newEstimator(sysConfig *models.SystemConfig) eta.Estimator {
+	return eta.Estimator{
+		Parallel:     sysConfig.Config.KITCHEN_PARALLEL_ORDERS,
+		DefaultRoute: sysConfig.Config.DEFAULT_DELIVERY_TIME,
+	}
+}
+
+// refreshEta recomputes and stores the delivery estimate of an order and
+// returns it formatted, or "" when the order has none. Estimates are best
+// effort: failures are logged and leave the stored estimate as it was.
+func refreshEta(ctx context.Context, orderRepo *postgres.OrderRepo, estimator eta.Estimator, orderId string, log *zap.Logger) string {
+	order, since, err := orderRepo.GetEtaOrder(ctx, orderId)
+	if err != nil {
+		log.Error("failed to get order for delivery estimate ", zap.String("order_id", orderId), zap.Error(err))
+		return ""
+	}
+
+	var estimate *time.Time
+	if at, ok := estimator.Estimate(order, since); ok {
+		estimate = &at
+	}
+	if err := orderRepo.SetEstimatedDeliveryTime(ctx, orderId, estimate); err != nil {
+		log.Error("failed to store delivery estimate ", zap.String("order_id", orderId), zap.Error(err))
+		return ""
+	}
+	if estimate == nil {
+		return ""
+	}
+
+	return estimate.Format(time.RFC3339)
+}
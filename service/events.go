@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"order_service/models"
+	"order_service/pkg/pubsub"
+	"order_service/storage/redis"
+	"time"
+
+	pb "order_service/genproto/order"
+
+	"go.uber.org/zap"
+)
+
+const (
+	orderChannelPrefix   = "orders:order:"
+	kitchenChannelPrefix = "orders:kitchen:"
+)
+
+// orderEvents fans order changes out to the watchers of the order and of its
+// kitchen on every instance of the service.
+type orderEvents struct {
+	bus pubsub.Bus
+	log *zap.Logger
+}
+
+func newOrderEvents(sysConfig *models.SystemConfig) *orderEvents {
+	return &orderEvents{bus: redis.NewPubSub(sysConfig.RedisDb), log: sysConfig.Logger}
+}
+
+// publish tells the watchers about a change of the order. Watching is best
+// effort, so failures are only logged.
+func (e *orderEvents) publish(ctx context.Context, eventType string, order *pb.OrderInfo, orderStatus, estimate string) {
+	event := &pb.OrderEvent{
+		Type:                  eventType,
+		OrderId:               order.Id,
+		KitchenId:             order.KitchenId,
+		UserId:                order.UserId,
+		Status:                orderStatus,
+		EstimatedDeliveryTime: estimate,
+		OccurredAt:            time.Now().Format(time.RFC3339),
+	}
+	message, err := json.Marshal(event)
+	if err != nil {
+		e.log.Error("failed to encode order event ", zap.Error(err))
+		return
+	}
+
+	for _, channel := range []string{orderChannelPrefix + order.Id, kitchenChannelPrefix + order.KitchenId} {
+		if err := e.bus.Publish(ctx, channel, message); err != nil {
+			e.log.Error("failed to publish order event ", zap.String("channel", channel), zap.Error(err))
+		}
+	}
+}
+
+// watch sends the events of a channel to a client until it goes away. When
+// set, subscribed runs once the subscription is in place, so state it sends
+// cannot miss a change.
+func (e *orderEvents) watch(ctx context.Context, channel string, subscribed func() error, send func(*pb.OrderEvent) error) error {
+	messages, err := e.bus.Subscribe(ctx, channel)
+	if err != nil {
+		e.log.Error("failed to subscribe to order events ", zap.String("channel", channel), zap.Error(err))
+		return err
+	}
+	if subscribed != nil {
+		if err := subscribed(); err != nil {
+			return err
+		}
+	}
+
+	for message := range messages {
+		event := &pb.OrderEvent{}
+		if err := json.Unmarshal(message, event); err != nil {
+			e.log.Error("failed to decode order event ", zap.Error(err))
+			continue
+		}
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
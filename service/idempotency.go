@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"order_service/pkg/idempotency"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// idempotent runs fn once per idempotency key sent by the client and converts
+// key misuse into gRPC statuses. A response that could not be saved for
+// replays is still returned, as fn has already taken effect.
+func idempotent[T proto.Message](ctx context.Context, store idempotency.Store, ttl time.Duration, log *zap.Logger,
+	scope string, req proto.Message, res T, fn func() (T, error)) (T, error) {
+	out, err := idempotency.Do(ctx, store, ttl, scope, req, res, fn)
+	switch {
+	case errors.Is(err, idempotency.ErrNotSaved):
+		log.Error("failed to save idempotent response ", zap.String("scope", scope), zap.Error(err))
+		return out, nil
+	case errors.Is(err, idempotency.ErrConflict):
+		return out, status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, idempotency.ErrInProgress):
+		return out, status.Error(codes.Aborted, err.Error())
+	}
+	return out, err
+}
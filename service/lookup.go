@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"order_service/models"
+	"order_service/pkg/lookup"
+	"order_service/storage/redis"
+
+	pbk "order_service/genproto/kitchen"
+	pbu "order_service/genproto/user"
+)
+
+// kitchenSummary and userSummary are the parts of kitchens and users of the
+// auth service that listings show. They are cached in Redis.
+type kitchenSummary struct {
+	Name        string `json:"name"`
+	CuisineType string `json:"cuisine_type"`
+}
+
+type userSummary struct {
+	Username string `json:"username"`
+}
+
+type lookups struct {
+	kitchens *lookup.Lookup[kitchenSummary]
+	users    *lookup.Lookup[userSummary]
+}
+
+func newLookups(sysConfig *models.SystemConfig, kitchenClient pbk.KitchenClient, userClient pbu.UserServiceClient) *lookups {
+	cache := redis.NewLookupCache(sysConfig.RedisDb)
+	ttl, workers := sysConfig.Config.LOOKUP_CACHE_TTL, sysConfig.Config.LOOKUP_WORKERS
+
+	return &lookups{
+		kitchens: lookup.New("kitchen:", func(ctx context.Context, id string) (kitchenSummary, error) {
+			kitchen, err := kitchenClient.GetKitchenById(ctx, &pbk.Id{Id: id})
+			if err != nil {
+				return kitchenSummary{}, err
+			}
+			return kitchenSummary{Name: kitchen.Name, CuisineType: kitchen.CuisineType}, nil
+		}, cache, ttl, workers),
+		users: lookup.New("user:", func(ctx context.Context, id string) (userSummary, error) {
+			user, err := userClient.GetProfile(ctx, &pbu.Id{Id: id})
+			if err != nil {
+				return userSummary{}, err
+			}
+			return userSummary{Username: user.Username}, nil
+		}, cache, ttl, workers),
+	}
+}
@@ -2,40 +2,102 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"order_service/models"
+	"order_service/pkg/auth"
+	"order_service/pkg/cancellation"
 	"order_service/pkg/connections"
+	"order_service/pkg/cursor"
+	"order_service/pkg/eta"
+	"order_service/pkg/idempotency"
+	"order_service/pkg/lifecycle"
+	"order_service/pkg/money"
+	"order_service/pkg/scheduling"
+	"order_service/pkg/validations"
 	"order_service/storage/postgres"
+	"order_service/storage/redis"
+	"sort"
+	"time"
 
-	pbd "order_service/genproto/dish"
 	pbk "order_service/genproto/kitchen"
 	pb "order_service/genproto/order"
 	pbu "order_service/genproto/user"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultOrderLimit = 10
+	maxOrderLimit     = 100
 )
 
 type OrderService struct {
-	orderRepo     *postgres.OrderRepo
-	dishRepo      *postgres.DishRepo
-	reviewRepo    *postgres.ReviewRepo
-	kitchenClient pbk.KitchenClient
-	userClient    pbu.UserServiceClient
-	log           *zap.Logger
+	orderRepo        *postgres.OrderRepo
+	reviewRepo       *postgres.ReviewRepo
+	workingHoursRepo *postgres.WorkingHoursRepo
+	kitchenClient    pbk.KitchenClient
+	userClient       pbu.UserServiceClient
+	lookups          *lookups
+	payments         *PaymentService
+	cancellation     cancellation.Policy
+	slotSize         time.Duration
+	slotCapacity     int
+	leadTime         time.Duration
+	estimator        eta.Estimator
+	events           *orderEvents
+	currency         string
+	location         *time.Location
+	idempotency      idempotency.Store
+	idempotencyTTL   time.Duration
+	log              *zap.Logger
 	pb.UnimplementedOrderServer
 }
 
-func NewOrderService(sysConfig *models.SystemConfig) *OrderService {
+func NewOrderService(sysConfig *models.SystemConfig, payments *PaymentService) *OrderService {
+	location, err := time.LoadLocation(sysConfig.Config.TIME_ZONE)
+	if err != nil {
+		sysConfig.Logger.Fatal("Failed to load time zone ", zap.Error(err))
+		return nil
+	}
+
+	kitchenClient := connections.NewKitchenService(sysConfig)
+	userClient := connections.NewUserService(sysConfig)
+
 	return &OrderService{
-		orderRepo:     postgres.NewOrderRepo(sysConfig.PostgresDb),
-		dishRepo:      postgres.NewDishRepo(sysConfig.PostgresDb),
-		reviewRepo:    postgres.NewReviewRepo(sysConfig.PostgresDb),
-		kitchenClient: connections.NewKitchenService(sysConfig),
-		userClient:    connections.NewUserService(sysConfig),
-		log:           sysConfig.Logger,
+		orderRepo:        postgres.NewOrderRepo(sysConfig.PostgresDb),
+		reviewRepo:       postgres.NewReviewRepo(sysConfig.PostgresDb),
+		workingHoursRepo: postgres.NewWorkingHoursRepo(sysConfig.PostgresDb),
+		kitchenClient:    kitchenClient,
+		userClient:       userClient,
+		lookups:          newLookups(sysConfig, kitchenClient, userClient),
+		payments:         payments,
+		cancellation:     cancellation.Policy{PreparingFeePercent: sysConfig.Config.CANCELLATION_FEE_PERCENT},
+		slotSize:         sysConfig.Config.SLOT_DURATION,
+		slotCapacity:     sysConfig.Config.SLOT_CAPACITY,
+		leadTime:         sysConfig.Config.SCHEDULE_LEAD_TIME,
+		estimator:        newEstimator(sysConfig),
+		events:           newOrderEvents(sysConfig),
+		currency:         sysConfig.Config.CURRENCY,
+		location:         location,
+		idempotency:      redis.NewIdempotencyStore(sysConfig.RedisDb),
+		idempotencyTTL:   sysConfig.Config.IDEMPOTENCY_TTL,
+		log:              sysConfig.Logger,
 	}
 }
 
+// CreateOrder places the order once per idempotency key, so that retried
+// requests replay the order created first.
 func (o *OrderService) CreateOrder(ctx context.Context, order *pb.ReqCreateOrder) (*pb.OrderInfo, error) {
+	return idempotent(ctx, o.idempotency, o.idempotencyTTL, o.log, "CreateOrder", order, &pb.OrderInfo{},
+		func() (*pb.OrderInfo, error) {
+			return o.createOrder(ctx, order)
+		})
+}
+
+func (o *OrderService) createOrder(ctx context.Context, order *pb.ReqCreateOrder) (*pb.OrderInfo, error) {
 
 	_, err := o.kitchenClient.ValidateKitchenId(ctx, &pbk.Id{Id: order.KitchenId})
 	if err != nil {
@@ -48,84 +110,311 @@ func (o *OrderService) CreateOrder(ctx context.Context, order *pb.ReqCreateOrder
 		return nil, err
 	}
 
-	var total float64
-	for _, item := range order.Items {
-		dish, err := o.dishRepo.GetDishById(ctx, &pbd.Id{Id: item.DishId})
+	// Orders without a delivery time are delivered as soon as possible. Orders
+	// due later than the lead time are held as scheduled until the scheduler
+	// releases them to the kitchen.
+	now := time.Now()
+	var deliveryTime time.Time
+	if order.DeliveryTime != "" {
+		deliveryTime, err = time.Parse(time.RFC3339, order.DeliveryTime)
 		if err != nil {
-			o.log.Error("failed to get dish by id for order ", zap.Error(err))
+			return nil, status.Error(codes.InvalidArgument, "delivery time must be an RFC 3339 timestamp")
+		}
+		if !deliveryTime.After(now) {
+			return nil, status.Error(codes.InvalidArgument, "delivery time must be in the future")
+		}
+		if err := o.checkKitchenOpen(ctx, order.KitchenId, deliveryTime); err != nil {
 			return nil, err
 		}
-		total += float64(dish.Price)
 	}
-	res, err := o.orderRepo.CreateOrder(ctx, order, total)
+	scheduled := !deliveryTime.IsZero() && deliveryTime.Sub(now) > o.leadTime
+	if !scheduled {
+		if err := o.checkKitchenOpen(ctx, order.KitchenId, now); err != nil {
+			return nil, err
+		}
+	}
+
+	// The repo prices the items against dishes locked in the transaction that
+	// stores the order, so a concurrent menu change cannot slip in between.
+	var res *pb.OrderInfo
+	if deliveryTime.IsZero() {
+		res, err = o.orderRepo.CreateOrder(ctx, order)
+	} else {
+		orderStatus := lifecycle.Pending
+		if scheduled {
+			orderStatus = lifecycle.Scheduled
+		}
+		slot := scheduling.SlotAt(deliveryTime.In(o.location), o.slotSize)
+		res, err = o.orderRepo.CreateOrderInSlot(ctx, order, orderStatus, slot, o.slotCapacity)
+		if err == postgres.ErrSlotFull {
+			return nil, status.Errorf(codes.ResourceExhausted, "delivery slot starting at %s is full",
+				slot.Start.Format(time.RFC3339))
+		}
+	}
+	if errors.Is(err, postgres.ErrInvalidItems) {
+		o.log.Info("invalid order items ", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		o.log.Error("failed to create order ", zap.Error(err))
 		return nil, err
 	}
+	res.EstimatedDeliveryTime = refreshEta(ctx, o.orderRepo, o.estimator, res.Id, o.log)
+	o.events.publish(ctx, models.OrderEventCreated, res, res.Status, res.EstimatedDeliveryTime)
 
 	return res, nil
 }
 
-func (o *OrderService) UpdateOrderStatus(ctx context.Context, status *pb.Status) (*pb.StatusRes, error) {
-	res, err := o.orderRepo.UpdateOrderStatus(ctx, status)
+func (o *OrderService) UpdateOrderStatus(ctx context.Context, req *pb.Status) (*pb.StatusRes, error) {
+	if !lifecycle.IsValid(req.Status) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown order status %q", req.Status)
+	}
+	if req.Status == lifecycle.Paid {
+		return nil, status.Error(codes.InvalidArgument, "orders are marked paid by captured payments only")
+	}
+	if req.Status == lifecycle.Cancelled {
+		return nil, status.Error(codes.InvalidArgument, "orders are cancelled with CancelOrder only")
+	}
+
+	order, err := o.orderRepo.GetOrderById(ctx, req.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "order %s not found", req.Id)
+	}
+	if err != nil {
+		o.log.Error("failed to get order by id for status update ", zap.Error(err))
+		return nil, err
+	}
+	if err := authorizeKitchen(ctx, o.kitchenClient, order.KitchenId); err != nil {
+		return nil, err
+	}
+	if !lifecycle.CanTransition(order.Status, req.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "order cannot move from %q to %q", order.Status, req.Status)
+	}
+
+	// The actor is the authenticated caller; req.ActorId is not trusted.
+	principal, _ := auth.FromContext(ctx)
+	res, err := o.orderRepo.UpdateOrderStatus(ctx, req, order.Status, principal.UserId)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.FailedPrecondition, "order %s was changed concurrently, retry", req.Id)
+	}
 	if err != nil {
 		o.log.Error("failed to update status of order ", zap.Error(err))
 		return nil, err
 	}
+	res.EstimatedDeliveryTime = refreshEta(ctx, o.orderRepo, o.estimator, res.Id, o.log)
+	o.events.publish(ctx, models.OrderEventStatusChanged, order, res.Status, res.EstimatedDeliveryTime)
+
+	// Rejected orders are refunded in full. The order stays rejected when the
+	// refund fails; admins retry it with RefundPayment.
+	if res.Status == lifecycle.Rejected {
+		noFee := money.New(0, order.GetTotalMoney().GetCurrency())
+		if _, err := o.payments.refundCancelledOrder(ctx, order.Id, noFee); err != nil {
+			o.log.Error("failed to refund rejected order ", zap.String("order_id", order.Id), zap.Error(err))
+		}
+	}
 
-	return res, err
+	return res, nil
 }
 
 func (o *OrderService) GetOrderById(ctx context.Context, id *pb.Id) (*pb.OrderInfo, error) {
 	res, err := o.orderRepo.GetOrderById(ctx, id.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "order %s not found", id.Id)
+	}
 	if err != nil {
 		o.log.Error("failed to get order by id ", zap.Error(err))
 		return nil, err
 	}
+	if err := authorizeOrder(ctx, o.kitchenClient, res); err != nil {
+		return nil, err
+	}
+
+	res.StatusHistory, err = o.orderRepo.GetStatusHistory(ctx, id.Id)
+	if err != nil {
+		o.log.Error("failed to get status history of order ", zap.Error(err))
+		return nil, err
+	}
 
 	return res, err
 }
 
 func (o *OrderService) GetOrdersForUser(ctx context.Context, filter *pb.Filter) (*pb.Orders, error) {
+	if err := o.validateFilter(filter); err != nil {
+		return nil, err
+	}
+
 	res, err := o.orderRepo.GetOrdersForUser(ctx, filter)
+	if err == cursor.ErrInvalid {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		o.log.Error("failed to get orders for user ", zap.Error(err))
 		return nil, err
 	}
 
-	for i := 0; i < len(res.Orders); i++ {
-		user, err := o.userClient.GetProfile(ctx, &pbu.Id{Id: res.Orders[i].UserId})
-		if err != nil {
-			o.log.Error("failed to get user profile for order ", zap.Error(err))
-			return nil, err
-		}
-		res.Orders[i].Username = user.Username
-	}
+	o.setUsernames(ctx, res.Orders)
 
-	return res, err
+	return res, nil
 }
 
 func (o *OrderService) GetOrdersForChef(ctx context.Context, filter *pb.Filter) (*pb.Orders, error) {
+	if err := o.validateFilter(filter); err != nil {
+		return nil, err
+	}
+
 	res, err := o.orderRepo.GetOrdersForChef(ctx, filter)
+	if err == cursor.ErrInvalid {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		o.log.Error("failed to get orders for chef ", zap.Error(err))
 		return nil, err
 	}
 
-	for i := 0; i < len(res.Orders); i++ {
-		user, err := o.userClient.GetProfile(ctx, &pbu.Id{Id: res.Orders[i].UserId})
-		if err != nil {
-			o.log.Error("failed to get user profile for order ", zap.Error(err))
+	o.setUsernames(ctx, res.Orders)
+
+	return res, nil
+}
+
+// CancelOrder cancels an order on behalf of its customer or its kitchen and
+// refunds what was captured for it. Customers pay the fee of the cancellation
+// policy; cancellations by the kitchen or an admin are free.
+func (o *OrderService) CancelOrder(ctx context.Context, req *pb.ReqCancelOrder) (*pb.CancelOrderRes, error) {
+	switch req.Reason {
+	case models.CancelReasonChangedMind, models.CancelReasonOrderedByMistake, models.CancelReasonTooSlow,
+		models.CancelReasonKitchenUnavailable, models.CancelReasonOutOfStock, models.CancelReasonOther:
+	case "":
+		return nil, status.Error(codes.InvalidArgument, "cancellation reason is required")
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown cancellation reason %q", req.Reason)
+	}
+
+	order, err := o.orderRepo.GetOrderById(ctx, req.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "order %s not found", req.Id)
+	}
+	if err != nil {
+		o.log.Error("failed to get order by id for cancel ", zap.Error(err))
+		return nil, err
+	}
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "access token is required")
+	}
+	byCustomer := !principal.IsAdmin() && principal.UserId == order.UserId
+	if !byCustomer {
+		if err := authorizeKitchen(ctx, o.kitchenClient, order.KitchenId); err != nil {
 			return nil, err
 		}
-		res.Orders[i].Username = user.Username
 	}
 
-	return res, err
+	fee, err := o.cancellation.Fee(order.Status, money.FromProto(order.TotalMoney))
+	if err == cancellation.ErrTooLate {
+		return nil, status.Errorf(codes.FailedPrecondition, "order %s is %s and can no longer be cancelled", order.Id,
+			order.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !byCustomer {
+		fee = money.New(0, fee.Currency)
+	}
+
+	cancelled, err := o.orderRepo.CancelOrder(ctx, req, order.Status, principal.UserId, fee)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.FailedPrecondition, "order %s was changed concurrently, retry", req.Id)
+	}
+	if err != nil {
+		o.log.Error("failed to cancel order ", zap.Error(err))
+		return nil, err
+	}
+	refreshEta(ctx, o.orderRepo, o.estimator, order.Id, o.log)
+	o.events.publish(ctx, models.OrderEventStatusChanged, order, cancelled.Status, "")
+
+	res := &pb.CancelOrderRes{
+		Id:        cancelled.Id,
+		Status:    cancelled.Status,
+		Reason:    req.Reason,
+		Fee:       &pb.Money{Amount: fee.Amount, Currency: fee.Currency},
+		UpdatedAt: cancelled.UpdatedAt,
+	}
+
+	// The order stays cancelled when the refund fails; admins retry it with
+	// RefundPayment.
+	refund, err := o.payments.refundCancelledOrder(ctx, order.Id, fee)
+	if err != nil {
+		o.log.Error("failed to refund cancelled order ", zap.String("order_id", order.Id), zap.Error(err))
+		res.RefundStatus = models.RefundFailed
+		return res, nil
+	}
+	if refund != nil {
+		res.Refund = &pb.Money{Amount: refund.Amount.Amount, Currency: refund.Amount.Currency}
+		res.RefundStatus = refund.Status
+	}
+
+	return res, nil
 }
 
+// validateFilter checks the filters of an order listing and clamps its limit.
+func (o *OrderService) validateFilter(filter *pb.Filter) error {
+	switch filter.Sort {
+	case "", models.OrderSortNewest, models.OrderSortOldest, models.OrderSortHighest, models.OrderSortLowest:
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown order sort %q", filter.Sort)
+	}
+	if filter.Status != "" && !lifecycle.IsValid(filter.Status) {
+		return status.Errorf(codes.InvalidArgument, "unknown order status %q", filter.Status)
+	}
+	for _, date := range []string{filter.StartDate, filter.EndDate} {
+		if date == "" {
+			continue
+		}
+		if err := validations.ValidateDate(date); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	for _, amount := range []*pb.Money{filter.MinAmount, filter.MaxAmount} {
+		if amount != nil && amount.Currency != "" && amount.Currency != o.currency {
+			return status.Errorf(codes.InvalidArgument, "amounts must be in %s", o.currency)
+		}
+	}
+	if filter.MinAmount != nil && filter.MaxAmount != nil && filter.MinAmount.Amount > filter.MaxAmount.Amount {
+		return status.Error(codes.InvalidArgument, "min amount is greater than max amount")
+	}
+
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultOrderLimit
+	}
+	if filter.Limit > maxOrderLimit {
+		filter.Limit = maxOrderLimit
+	}
+
+	return nil
+}
+
+// DeleteOrder hides a finished order of the user. Orders still in progress
+// have to be cancelled with CancelOrder first.
 func (o *OrderService) DeleteOrder(ctx context.Context, id *pb.Id) (*pb.Void, error) {
-	err := o.orderRepo.DeleteOrder(ctx, id.Id)
+	order, err := o.orderRepo.GetOrderById(ctx, id.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "order %s not found", id.Id)
+	}
+	if err != nil {
+		o.log.Error("failed to get order by id for delete ", zap.Error(err))
+		return nil, err
+	}
+	if err := authorizeUser(ctx, order.UserId); err != nil {
+		return nil, err
+	}
+	if !lifecycle.IsFinal(order.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "order in status %q is not finished, cancel it first", order.Status)
+	}
+
+	err = o.orderRepo.DeleteOrder(ctx, id.Id)
 	if err != nil {
 		o.log.Error("failed to delete order ", zap.Error(err))
 		return nil, err
@@ -145,7 +434,7 @@ func (o *OrderService) ValidateOrderId(ctx context.Context, id *pb.Id) (*pb.Void
 }
 
 func (o *OrderService) GetKitchenStatistics(ctx context.Context, filter *pb.DateFilter) (*pb.KitchenStatistics, error) {
-	reviewStats, err := o.reviewRepo.GetStatisticsOfReviews(ctx, filter.Id)
+	reviewStats, err := o.reviewRepo.GetRatingStats(ctx, filter.Id)
 	if err != nil {
 		o.log.Error("failed to get review stats", zap.Error(err))
 		return nil, err
@@ -162,22 +451,40 @@ func (o *OrderService) GetKitchenStatistics(ctx context.Context, filter *pb.Date
 		return nil, err
 	}
 
-	for i := 0; i < len(statistics.TopDishes); i++{
-		dish, err := o.dishRepo.GetDishById(ctx, &pbd.Id{Id: statistics.TopDishes[i].Id})
-		if err != nil {
-			o.log.Info("failed to get dish by id", zap.Error(err))
-		}
-		statistics.TopDishes[i].Name = dish.Name
-		statistics.TopDishes[i].Revenue = dish.Price * float32(statistics.TopDishes[i].OrdersCount)
+	// Every kitchen works in the service-wide TIME_ZONE.
+	buckets, err := o.orderRepo.GetOrdersByHour(ctx, filter, o.location.String())
+	if err != nil {
+		o.log.Error("failed to get orders by hour", zap.Error(err))
+		return nil, err
+	}
+	statistics.BusiestHours, err = busiestHours(buckets)
+	if err != nil {
+		o.log.Error("failed to merge orders by hour", zap.Error(err))
+		return nil, err
+	}
+	if filter.Heatmap {
+		statistics.Heatmap = heatmap(buckets)
+	}
+
+	revenue := rev.Revenue
+	if revenue.Currency == "" {
+		revenue.Currency = o.currency
 	}
 
 	statistics.AverageRating = reviewStats.AvarageRating
-	statistics.TotalRevenue = rev.Revenue
+	statistics.RatingHistogram = reviewStats.Histogram[:]
+	statistics.MonthlyAverageRating = reviewStats.Average30Days
+	statistics.QuarterlyAverageRating = reviewStats.Average90Days
+	statistics.BayesianRating = reviewStats.BayesianRating
+	statistics.TotalRevenue = revenue.Float()
+	statistics.TotalRevenueMoney = &pb.Money{Amount: revenue.Amount, Currency: revenue.Currency}
 	statistics.TotalOrders = int64(rev.TotalOrders)
 
 	return statistics, nil
 }
 
+// GetUserStatistics sums the orders of a user per kitchen and per cuisine
+// of those kitchens, and adds the average rating the user gave.
 func (o *OrderService) GetUserStatistics(ctx context.Context, filter *pb.DateFilter) (*pb.UserStatistics, error) {
 
 	statistics, err := o.orderRepo.GetUserStatistics(ctx, filter)
@@ -185,20 +492,88 @@ func (o *OrderService) GetUserStatistics(ctx context.Context, filter *pb.DateFil
 		o.log.Error("failed to get user stats", zap.Error(err))
 		return nil, err
 	}
-	totalSpent := 0
+
+	kitchenIds := make([]string, 0, len(statistics.FavoriteKitchens))
+	for _, k := range statistics.FavoriteKitchens {
+		kitchenIds = append(kitchenIds, k.Id)
+	}
+	kitchens, err := o.lookups.kitchens.Get(ctx, kitchenIds)
+	if err != nil {
+		o.log.Info("failed to get kitchens of user stats", zap.Error(err))
+	}
+
+	totalSpent := money.New(0, o.currency)
 	totalOrders := 0
-	for i := 0; i < len(statistics.FavoriteKitchens); i++{
-		kitchen, err := o.kitchenClient.GetKitchenById(ctx, &pbk.Id{Id: statistics.FavoriteKitchens[i].Id})
+	cuisines := map[string]*pb.CuisineStats{}
+	for _, k := range statistics.FavoriteKitchens {
+		spent := money.FromProto(k.TotalSpentMoney)
+		totalSpent, err = totalSpent.Add(spent)
+		if err != nil {
+			o.log.Error("failed to sum spendings of user", zap.Error(err))
+			return nil, err
+		}
+		totalOrders += int(k.OrdersCount)
+
+		kitchen, ok := kitchens[k.Id]
+		if !ok {
+			continue
+		}
+		k.Name = kitchen.Name
+
+		cuisine, ok := cuisines[kitchen.CuisineType]
+		if !ok {
+			cuisine = &pb.CuisineStats{CuisineType: kitchen.CuisineType, TotalSpentMoney: &pb.Money{Currency: spent.Currency}}
+			cuisines[kitchen.CuisineType] = cuisine
+		}
+		cuisineSpent, err := money.FromProto(cuisine.TotalSpentMoney).Add(spent)
 		if err != nil {
-			o.log.Info("failed to get kitchen by id", zap.Error(err))
+			o.log.Error("failed to sum spendings of user per cuisine", zap.Error(err))
+			return nil, err
+		}
+		cuisine.OrdersCount += k.OrdersCount
+		cuisine.TotalSpent = cuisineSpent.Float()
+		cuisine.TotalSpentMoney = &pb.Money{Amount: cuisineSpent.Amount, Currency: cuisineSpent.Currency}
+	}
+
+	for _, cuisine := range cuisines {
+		statistics.FavoriteCuisines = append(statistics.FavoriteCuisines, cuisine)
+	}
+	sort.Slice(statistics.FavoriteCuisines, func(i, j int) bool {
+		a, b := statistics.FavoriteCuisines[i], statistics.FavoriteCuisines[j]
+		if a.OrdersCount != b.OrdersCount {
+			return a.OrdersCount > b.OrdersCount
+		}
+		if a.TotalSpentMoney.Amount != b.TotalSpentMoney.Amount {
+			return a.TotalSpentMoney.Amount > b.TotalSpentMoney.Amount
 		}
-		statistics.FavoriteKitchens[i].Name = kitchen.Name	
-		totalSpent += int(statistics.FavoriteKitchens[i].TotalSpent)
-		totalOrders += int(statistics.FavoriteKitchens[i].OrdersCount)
+		return a.CuisineType < b.CuisineType
+	})
+
+	statistics.AverageRating, err = o.reviewRepo.GetUserAverageRating(ctx, filter.Id, filter.StartDate, filter.EndDate)
+	if err != nil {
+		o.log.Error("failed to get average rating of user", zap.Error(err))
+		return nil, err
 	}
 
-	statistics.TotalSpent = float64(totalSpent)
+	statistics.TotalSpent = totalSpent.Float()
+	statistics.TotalSpentMoney = &pb.Money{Amount: totalSpent.Amount, Currency: totalSpent.Currency}
 	statistics.TotalOrders = int64(totalOrders)
 
 	return statistics, nil
 }
+
+// setUsernames fills the usernames of the customers of the orders. Orders of
+// users that cannot be looked up are left without a name.
+func (o *OrderService) setUsernames(ctx context.Context, orders []*pb.OrderShortInfo) {
+	userIds := make([]string, 0, len(orders))
+	for _, order := range orders {
+		userIds = append(userIds, order.UserId)
+	}
+	users, err := o.lookups.users.Get(ctx, userIds)
+	if err != nil {
+		o.log.Error("failed to get user profiles for orders ", zap.Error(err))
+	}
+	for _, order := range orders {
+		order.Username = users[order.UserId].Username
+	}
+}
@@ -2,48 +2,402 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"order_service/models"
 	"order_service/pkg/connections"
+	"order_service/pkg/gateway"
+	"order_service/pkg/idempotency"
+	"order_service/pkg/lifecycle"
+	"order_service/pkg/money"
+	"order_service/pkg/validations"
+	"order_service/pkg/vault"
 	"order_service/storage/postgres"
+	"order_service/storage/redis"
+	"slices"
+	"time"
 
 	pbk "order_service/genproto/kitchen"
+	pbo "order_service/genproto/order"
 	pb "order_service/genproto/payment"
 	pbu "order_service/genproto/user"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type PaymentService struct {
-	paymentRepo   *postgres.PaymentRepo
-	orderRepo     *postgres.OrderRepo
-	kitchenClient pbk.KitchenClient
-	userClient    pbu.UserServiceClient
-	log           *zap.Logger
+	paymentRepo    *postgres.PaymentRepo
+	refundRepo     *postgres.RefundRepo
+	orderRepo      *postgres.OrderRepo
+	kitchenClient  pbk.KitchenClient
+	userClient     pbu.UserServiceClient
+	gateway        gateway.PaymentGateway
+	vault          vault.Vault
+	idempotency    idempotency.Store
+	idempotencyTTL time.Duration
+	events         *orderEvents
+	log            *zap.Logger
 	pb.UnimplementedPaymentServer
 }
 
-func NewPaymentService(sysConfig *models.SystemConfig) *PaymentService {
+func NewPaymentService(sysConfig *models.SystemConfig, gw gateway.PaymentGateway, v vault.Vault) *PaymentService {
 	return &PaymentService{
-		paymentRepo:   postgres.NewPaymentRepo(sysConfig.PostgresDb),
-		orderRepo:     postgres.NewOrderRepo(sysConfig.PostgresDb),
-		kitchenClient: connections.NewKitchenService(sysConfig),
-		userClient:    connections.NewUserService(sysConfig),
-		log:           sysConfig.Logger,
+		paymentRepo:    postgres.NewPaymentRepo(sysConfig.PostgresDb),
+		refundRepo:     postgres.NewRefundRepo(sysConfig.PostgresDb),
+		orderRepo:      postgres.NewOrderRepo(sysConfig.PostgresDb),
+		kitchenClient:  connections.NewKitchenService(sysConfig),
+		userClient:     connections.NewUserService(sysConfig),
+		gateway:        gw,
+		vault:          v,
+		idempotency:    redis.NewIdempotencyStore(sysConfig.RedisDb),
+		idempotencyTTL: sysConfig.Config.IDEMPOTENCY_TTL,
+		events:         newOrderEvents(sysConfig),
+		log:            sysConfig.Logger,
 	}
 }
 
+// CreatePayment charges the order. Card payments are authorized and captured
+// through the gateway and move the order to paid only once the capture
+// succeeds. Cash and pay later payments stay pending until collected.
+// A retry with the same idempotency key replays the first outcome instead of
+// charging the card again.
 func (p *PaymentService) CreatePayment(ctx context.Context, req *pb.ReqCreatePayment) (*pb.PaymentInfo, error) {
+	return idempotent(ctx, p.idempotency, p.idempotencyTTL, p.log, "CreatePayment", withoutCard(req), &pb.PaymentInfo{},
+		func() (*pb.PaymentInfo, error) {
+			return p.createPayment(ctx, req)
+		})
+}
+
+// withoutCard returns a copy of the request without the card details, so that
+// nothing derived from them is kept with the idempotency key.
+func withoutCard(req *pb.ReqCreatePayment) *pb.ReqCreatePayment {
+	clone := proto.Clone(req).(*pb.ReqCreatePayment)
+	clone.CardNumber, clone.ExpiryDate, clone.Cvv = "", "", ""
+	return clone
+}
+
+// payableStatuses are the order statuses that accept a payment.
+var payableStatuses = []string{lifecycle.Pending, lifecycle.Scheduled}
+
+func (p *PaymentService) createPayment(ctx context.Context, req *pb.ReqCreatePayment) (*pb.PaymentInfo, error) {
+	switch req.PaymentMethod {
+	case models.PaymentMethodCreditCard, models.PaymentMethodDebitCard, models.PaymentMethodCash,
+		models.PaymentMethodPayLater:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown payment method %q", req.PaymentMethod)
+	}
+
 	order, err := p.orderRepo.GetOrderById(ctx, req.OrderId)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "order %s not found", req.OrderId)
+	}
 	if err != nil {
 		p.log.Error("Failed to get order by id for id ", zap.Error(err))
 		return nil, err
 	}
-	
-	res, err := p.paymentRepo.CreatePayment(ctx, req, order.TotalAmount)
+	if err := authorizeUser(ctx, order.UserId); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(payableStatuses, order.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "order %s is %s and cannot be paid", order.Id, order.Status)
+	}
+	amount := money.FromProto(order.TotalMoney)
+
+	isCard := req.PaymentMethod == models.PaymentMethodCreditCard || req.PaymentMethod == models.PaymentMethodDebitCard
+	var card *vault.Token
+	if isCard {
+		card, err = p.tokenize(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := p.paymentRepo.CreatePayment(ctx, req, amount, card, payableStatuses)
+	if err == postgres.ErrPaymentExists {
+		return nil, status.Errorf(codes.AlreadyExists, "order %s already has a payment in progress or captured", order.Id)
+	}
+	if err == postgres.ErrOrderNotPayable {
+		return nil, status.Errorf(codes.FailedPrecondition, "order %s can no longer be paid", order.Id)
+	}
 	if err != nil {
 		p.log.Error("Failed to create payment ", zap.Error(err))
 		return nil, err
 	}
+	if !isCard {
+		return res, nil
+	}
+
+	res.TransactionId, err = p.gateway.Authorize(ctx, gateway.AuthorizeRequest{
+		OrderId:   order.Id,
+		Amount:    amount,
+		CardToken: card.Token,
+		Cvv:       req.Cvv,
+	})
+	if err != nil {
+		return nil, p.fail(ctx, res, "authorization", err)
+	}
+	res, err = p.setStatus(ctx, res, models.PaymentAuthorized)
+	if err != nil {
+		return nil, err
+	}
+
+	// The order may have been cancelled or rejected while the card was being
+	// authorized. Later changes are caught by markPaid.
+	current, err := p.orderRepo.GetOrderById(ctx, order.Id)
+	if err != nil {
+		p.log.Error("Failed to get order before capture ", zap.Error(err))
+		p.voidAuthorization(ctx, res, err)
+		return nil, err
+	}
+	if !slices.Contains(payableStatuses, current.Status) {
+		p.voidAuthorization(ctx, res, postgres.ErrOrderNotPayable)
+		return nil, status.Errorf(codes.FailedPrecondition, "order %s was %s while it was being paid", order.Id,
+			current.Status)
+	}
+
+	err = p.gateway.Capture(ctx, res.TransactionId, amount)
+	if err != nil {
+		if voidErr := p.gateway.Void(ctx, res.TransactionId); voidErr != nil {
+			p.log.Error("Failed to void authorization ", zap.String("transaction_id", res.TransactionId),
+				zap.Error(voidErr))
+		}
+		return nil, p.fail(ctx, res, "capture", err)
+	}
+	res, err = p.setStatus(ctx, res, models.PaymentCaptured)
+	if err != nil {
+		return nil, err
+	}
+
+	orderStatus, err := p.markPaid(ctx, order.Id)
+	if err != nil {
+		return nil, p.reverseCapture(ctx, res, amount, err)
+	}
+	if orderStatus == lifecycle.Paid {
+		p.events.publish(ctx, models.OrderEventStatusChanged, order, lifecycle.Paid, order.EstimatedDeliveryTime)
+	}
+
+	return res, nil
+}
+
+// voidAuthorization releases the authorization of a payment that will not be
+// captured and marks the payment voided. Failures are logged; the
+// authorization then expires at the gateway.
+func (p *PaymentService) voidAuthorization(ctx context.Context, payment *pb.PaymentInfo, cause error) {
+	if err := p.gateway.Void(ctx, payment.TransactionId); err != nil {
+		p.log.Error("Failed to void authorization ", zap.String("transaction_id", payment.TransactionId),
+			zap.Error(err))
+		return
+	}
+
+	payment.Status = models.PaymentVoided
+	payment.FailureReason = cause.Error()
+	if _, err := p.paymentRepo.UpdatePaymentStatus(ctx, payment); err != nil {
+		p.log.Error("Failed to mark payment as voided ", zap.Error(err))
+	}
+}
+
+// markPaid moves the order of a captured payment from Pending to Paid and
+// returns its status. Scheduled orders stay scheduled; the scheduler releases
+// them as paid. It returns postgres.ErrOrderNotPayable when the order has left
+// both statuses meanwhile, for example because it was cancelled while the
+// payment was in flight.
+func (p *PaymentService) markPaid(ctx context.Context, orderId string) (string, error) {
+	for {
+		_, err := p.orderRepo.UpdateOrderStatus(ctx, &pbo.Status{Id: orderId, Status: lifecycle.Paid}, lifecycle.Pending, "")
+		if err != sql.ErrNoRows {
+			return lifecycle.Paid, err
+		}
+
+		order, err := p.orderRepo.GetOrderById(ctx, orderId)
+		if err != nil {
+			return "", err
+		}
+		switch order.Status {
+		case lifecycle.Scheduled:
+			return order.Status, nil
+		case lifecycle.Pending:
+			// Released by the scheduler meanwhile.
+			continue
+		}
+		return "", postgres.ErrOrderNotPayable
+	}
+}
+
+// reverseCapture refunds a captured payment whose order could not be marked
+// paid, so the customer is not charged for an order the kitchen will not get.
+func (p *PaymentService) reverseCapture(ctx context.Context, payment *pb.PaymentInfo, amount money.Money, cause error) error {
+	p.log.Error("Failed to mark order paid, refunding capture ", zap.String("order_id", payment.OrderId),
+		zap.Error(cause))
+
+	if _, err := p.refund(ctx, payment, amount, "order could not be marked paid"); err != nil {
+		p.log.Error("Failed to refund capture of unpaid order ", zap.String("payment_id", payment.Id), zap.Error(err))
+		return status.Errorf(codes.Internal, "payment %s was captured but order %s could not be marked paid", payment.Id,
+			payment.OrderId)
+	}
+	if cause == postgres.ErrOrderNotPayable {
+		return status.Errorf(codes.FailedPrecondition, "order %s changed while it was being paid, payment %s was refunded",
+			payment.OrderId, payment.Id)
+	}
+	return status.Errorf(codes.Unavailable, "order %s could not be marked paid, payment %s was refunded",
+		payment.OrderId, payment.Id)
+}
+
+// RefundPayment returns captured money to the card. Without an amount the
+// whole remaining captured amount is refunded.
+func (p *PaymentService) RefundPayment(ctx context.Context, req *pb.ReqRefundPayment) (*pb.RefundInfo, error) {
+	payment, err := p.paymentRepo.GetPaymentById(ctx, req.PaymentId)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "payment %s not found", req.PaymentId)
+	}
+	if err != nil {
+		p.log.Error("Failed to get payment by id ", zap.Error(err))
+		return nil, err
+	}
+
+	captured := money.FromProto(payment.AmountMoney)
+	remaining, err := captured.Sub(money.FromProto(payment.RefundedMoney))
+	if err != nil {
+		return nil, err
+	}
+	amount := remaining
+	if req.Amount != nil {
+		amount = money.FromProto(req.Amount)
+		if amount.Currency == "" {
+			amount.Currency = captured.Currency
+		}
+	}
+	if amount.Currency != captured.Currency {
+		return nil, status.Errorf(codes.InvalidArgument, "refund currency %s does not match payment currency %s",
+			amount.Currency, captured.Currency)
+	}
+	if amount.Amount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "refund amount must be positive")
+	}
+
+	return p.refund(ctx, payment, amount, req.Reason)
+}
+
+// refundCancelledOrder refunds what was captured for a cancelled order minus
+// the cancellation fee. It returns nil when nothing was captured or the fee
+// takes all of it.
+func (p *PaymentService) refundCancelledOrder(ctx context.Context, orderId string, fee money.Money) (*pb.RefundInfo, error) {
+	payment, err := p.paymentRepo.GetRefundablePaymentByOrderId(ctx, orderId)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		p.log.Error("Failed to get payment of cancelled order ", zap.Error(err))
+		return nil, err
+	}
+
+	remaining, err := money.FromProto(payment.AmountMoney).Sub(money.FromProto(payment.RefundedMoney))
+	if err != nil {
+		return nil, err
+	}
+	amount, err := remaining.Sub(fee)
+	if err != nil {
+		return nil, err
+	}
+	if amount.Amount <= 0 {
+		return nil, nil
+	}
+
+	return p.refund(ctx, payment, amount, "order cancelled")
+}
+
+// refund reserves the refund before calling the gateway so that concurrent
+// refunds of the same payment are checked against each other.
+func (p *PaymentService) refund(ctx context.Context, payment *pb.PaymentInfo, amount money.Money, reason string) (*pb.RefundInfo, error) {
+	switch payment.Status {
+	case models.PaymentCaptured, models.PaymentPartiallyRefunded:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "payment %s is %s and cannot be refunded", payment.Id,
+			payment.Status)
+	}
+
+	res, err := p.refundRepo.CreateRefund(ctx, payment.Id, amount, reason)
+	if err == postgres.ErrRefundExceedsCaptured {
+		return nil, status.Errorf(codes.FailedPrecondition, "refund of %s exceeds the captured amount of payment %s",
+			amount, payment.Id)
+	}
+	if err != nil {
+		p.log.Error("Failed to create refund ", zap.Error(err))
+		return nil, err
+	}
+
+	err = p.gateway.Refund(ctx, payment.TransactionId, amount)
+	if err != nil {
+		res.Status = models.RefundFailed
+		res.FailureReason = err.Error()
+		if _, updateErr := p.refundRepo.UpdateRefundStatus(ctx, res); updateErr != nil {
+			p.log.Error("Failed to mark refund as failed ", zap.Error(updateErr))
+		}
+		return nil, p.gatewayError(payment.Id, "refund", err)
+	}
+
+	res.Status = models.RefundSucceeded
+	_, err = p.refundRepo.UpdateRefundStatus(ctx, res)
+	if err != nil {
+		p.log.Error("Failed to update refund status ", zap.String("refund_id", res.Id), zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// tokenize validates the card of the request and swaps it for a vault token.
+// Card details, and the CVV in particular, are never logged.
+func (p *PaymentService) tokenize(ctx context.Context, req *pb.ReqCreatePayment) (*vault.Token, error) {
+	if err := validations.ValidateCardNumber(req.CardNumber); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validations.ValidateExpiryDate(req.ExpiryDate, time.Now()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validations.ValidateCvv(req.Cvv); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	token, err := p.vault.Tokenize(ctx, vault.Card{Number: req.CardNumber, ExpiryDate: req.ExpiryDate})
+	if err != nil {
+		p.log.Error("Failed to tokenize card ", zap.Error(err))
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (p *PaymentService) setStatus(ctx context.Context, payment *pb.PaymentInfo, paymentStatus string) (*pb.PaymentInfo, error) {
+	payment.Status = paymentStatus
+	res, err := p.paymentRepo.UpdatePaymentStatus(ctx, payment)
+	if err != nil {
+		p.log.Error("Failed to update payment status ", zap.String("status", paymentStatus), zap.Error(err))
+		return nil, err
+	}
 
 	return res, nil
 }
+
+// fail marks the payment failed and converts a gateway error of the given
+// step into a gRPC status.
+func (p *PaymentService) fail(ctx context.Context, payment *pb.PaymentInfo, step string, cause error) error {
+	payment.Status = models.PaymentFailed
+	payment.FailureReason = cause.Error()
+	if _, err := p.paymentRepo.UpdatePaymentStatus(ctx, payment); err != nil {
+		p.log.Error("Failed to mark payment as failed ", zap.Error(err))
+	}
+
+	return p.gatewayError(payment.Id, step, cause)
+}
+
+func (p *PaymentService) gatewayError(paymentId, step string, cause error) error {
+	if errors.Is(cause, gateway.ErrDeclined) {
+		p.log.Info("Payment "+step+" declined ", zap.String("payment_id", paymentId), zap.Error(cause))
+		return status.Errorf(codes.FailedPrecondition, "payment %s failed: %v", step, cause)
+	}
+	p.log.Error("Payment gateway "+step+" error ", zap.String("payment_id", paymentId), zap.Error(cause))
+	return status.Errorf(codes.Unavailable, "payment %s failed: %v", step, cause)
+}
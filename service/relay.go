@@ -0,0 +1,20 @@
+package service
+
+import (
+	"order_service/models"
+	"order_service/pkg/broker"
+	"order_service/pkg/outbox"
+	"order_service/storage/postgres"
+)
+
+// NewOutboxRelay relays the events of the outbox table to b.
+func NewOutboxRelay(sysConfig *models.SystemConfig, b broker.Broker) *outbox.Relay {
+	return outbox.NewRelay(postgres.NewOutboxRepo(sysConfig.PostgresDb), b, outbox.Config{
+		BatchSize:   sysConfig.Config.OUTBOX_BATCH_SIZE,
+		Interval:    sysConfig.Config.OUTBOX_RELAY_INTERVAL,
+		Lease:       sysConfig.Config.OUTBOX_LEASE,
+		MaxAttempts: sysConfig.Config.OUTBOX_MAX_ATTEMPTS,
+		Backoff:     sysConfig.Config.OUTBOX_RETRY_BACKOFF,
+		MaxBackoff:  sysConfig.Config.OUTBOX_MAX_BACKOFF,
+	}, sysConfig.Logger)
+}
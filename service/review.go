@@ -2,8 +2,13 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"order_service/models"
+	"order_service/pkg/auth"
 	"order_service/pkg/connections"
+	"order_service/pkg/cursor"
+	"order_service/pkg/lifecycle"
+	"order_service/pkg/validations"
 	"order_service/storage/postgres"
 
 	pbk "order_service/genproto/kitchen"
@@ -11,27 +16,67 @@ import (
 	pbu "order_service/genproto/user"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultReviewLimit = 10
+	maxReviewLimit     = 100
 )
 
 type ReviewService struct {
 	reviewRepo    *postgres.ReviewRepo
+	orderRepo     *postgres.OrderRepo
 	kitchenClient pbk.KitchenClient
 	userClient    pbu.UserServiceClient
+	lookups       *lookups
 	log           *zap.Logger
 	pb.UnimplementedReviewServer
 }
 
 func NewReviewService(sysConfig *models.SystemConfig) *ReviewService {
+	kitchenClient := connections.NewKitchenService(sysConfig)
+	userClient := connections.NewUserService(sysConfig)
+
 	return &ReviewService{
 		reviewRepo:    postgres.NewReviewRepo(sysConfig.PostgresDb),
-		kitchenClient: connections.NewKitchenService(sysConfig),
-		userClient:    connections.NewUserService(sysConfig),
+		orderRepo:     postgres.NewOrderRepo(sysConfig.PostgresDb),
+		kitchenClient: kitchenClient,
+		userClient:    userClient,
+		lookups:       newLookups(sysConfig, kitchenClient, userClient),
 		log:           sysConfig.Logger,
 	}
 }
 
+// CreateReview accepts one review per delivered order, written by the customer
+// of the order. The kitchen is taken from the order, not from the request.
 func (r *ReviewService) CreateReview(ctx context.Context, req *pb.ReqCreateReview) (*pb.ReviewInfo, error){
+	if err := validations.ValidateRating(req.Rating); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	order, err := r.orderRepo.GetOrderById(ctx, req.OrderId)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "order %s not found", req.OrderId)
+	}
+	if err != nil {
+		r.log.Error("failed to get order for review ", zap.Error(err))
+		return nil, err
+	}
+	if order.UserId != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "only the customer of the order can review it")
+	}
+	if order.Status != lifecycle.Delivered {
+		return nil, status.Errorf(codes.FailedPrecondition, "order %s is %s, only delivered orders can be reviewed",
+			order.Id, order.Status)
+	}
+	req.KitchenId = order.KitchenId
+
 	res, err := r.reviewRepo.CreateReview(ctx, req)
+	if err == postgres.ErrReviewExists {
+		return nil, status.Errorf(codes.AlreadyExists, "order %s has already been reviewed", req.OrderId)
+	}
 	if err != nil {
 		r.log.Error("failed to create review ", zap.Error(err))
 		return nil, err
@@ -40,18 +85,66 @@ func (r *ReviewService) CreateReview(ctx context.Context, req *pb.ReqCreateRevie
 	return res, nil
 }
 
+// GetReviewsByKitchenId shows customers visible reviews only. Admins and the
+// chef of the kitchen also see flagged and hidden ones.
 func (r *ReviewService) GetReviewsByKitchenId(ctx context.Context, filter *pb.Filter) (*pb.Reviews, error){
-	res, err := r.reviewRepo.GetReviewsByKitchenId(ctx, filter)
+	visibleOnly := true
+	if principal, ok := auth.FromContext(ctx); ok {
+		switch {
+		case principal.IsAdmin():
+			visibleOnly = false
+		case principal.Role == auth.RoleChef:
+			visibleOnly = authorizeKitchen(ctx, r.kitchenClient, filter.Id) != nil
+		}
+	}
+
+	switch filter.Sort {
+	case "", models.ReviewSortNewest, models.ReviewSortHighest, models.ReviewSortLowest, models.ReviewSortHasComment:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown review sort %q", filter.Sort)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultReviewLimit
+	}
+	if filter.Limit > maxReviewLimit {
+		filter.Limit = maxReviewLimit
+	}
+
+	res, err := r.reviewRepo.GetReviewsByKitchenId(ctx, filter, visibleOnly)
+	if err == cursor.ErrInvalid {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
-		r.log.Error("failed to create review ", zap.Error(err))
+		r.log.Error("failed to get reviews of kitchen ", zap.Error(err))
 		return nil, err
 	}
+
+	userIds := make([]string, 0, len(res.Reviews))
+	for _, review := range res.Reviews {
+		userIds = append(userIds, review.UserId)
+	}
+	usernames := r.usernames(ctx, userIds)
+	for _, review := range res.Reviews {
+		review.Username = usernames[review.UserId]
+	}
 	
 	return res, nil
 }
 
 func (r *ReviewService) DeleteComment(ctx context.Context, id *pb.Id) (*pb.Void, error){
-	err := r.reviewRepo.DeleteReview(ctx, id.Id)
+	userId, err := r.reviewRepo.GetReviewUserId(ctx, id.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "review %s not found", id.Id)
+	}
+	if err != nil {
+		r.log.Error("failed to get author of review ", zap.Error(err))
+		return nil, err
+	}
+	if err := authorizeUser(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	err = r.reviewRepo.DeleteReview(ctx, id.Id)
 	if err != nil {
 		r.log.Error("failed to create review ", zap.Error(err))
 		return nil, err
@@ -59,3 +152,166 @@ func (r *ReviewService) DeleteComment(ctx context.Context, id *pb.Id) (*pb.Void,
 	
 	return &pb.Void{}, err
 }
+
+// UpdateReview lets the author change the rating and comment. Earlier
+// versions are returned as the edit history.
+func (r *ReviewService) UpdateReview(ctx context.Context, req *pb.ReqUpdateReview) (*pb.ReviewInfo, error) {
+	if err := validations.ValidateRating(req.Rating); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	review, err := r.getReview(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeUser(ctx, review.UserId); err != nil {
+		return nil, err
+	}
+
+	res, err := r.reviewRepo.UpdateReview(ctx, req)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "review %s not found", req.Id)
+	}
+	if err != nil {
+		r.log.Error("failed to update review ", zap.Error(err))
+		return nil, err
+	}
+
+	if err := r.attachDetails(ctx, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ReplyToReview stores the answer of the chef owning the reviewed kitchen.
+func (r *ReviewService) ReplyToReview(ctx context.Context, req *pb.ReqReplyToReview) (*pb.ReviewReply, error) {
+	if req.Comment == "" {
+		return nil, status.Error(codes.InvalidArgument, "reply comment is required")
+	}
+
+	review, err := r.getReview(ctx, req.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeKitchen(ctx, r.kitchenClient, review.KitchenId); err != nil {
+		return nil, err
+	}
+	principal, _ := auth.FromContext(ctx)
+
+	res, err := r.reviewRepo.ReplyToReview(ctx, req, principal.UserId)
+	if err != nil {
+		r.log.Error("failed to reply to review ", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// FlagReview reports a review to the moderators and hides it from customers
+// until it is moderated.
+func (r *ReviewService) FlagReview(ctx context.Context, req *pb.ReqFlagReview) (*pb.Void, error) {
+	if _, err := r.getReview(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	err := r.reviewRepo.FlagReview(ctx, req)
+	if err != nil {
+		r.log.Error("failed to flag review ", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.Void{}, nil
+}
+
+// ModerateReview lets admins make a review visible again or hide it.
+func (r *ReviewService) ModerateReview(ctx context.Context, req *pb.ReqModerateReview) (*pb.ReviewInfo, error) {
+	if req.Status != models.ReviewVisible && req.Status != models.ReviewHidden {
+		return nil, status.Errorf(codes.InvalidArgument, "moderation status must be %s or %s", models.ReviewVisible,
+			models.ReviewHidden)
+	}
+
+	err := r.reviewRepo.ModerateReview(ctx, req)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "review %s not found", req.Id)
+	}
+	if err != nil {
+		r.log.Error("failed to moderate review ", zap.Error(err))
+		return nil, err
+	}
+
+	res, err := r.getReview(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.attachDetails(ctx, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// usernames looks the reviewers up. Users that cannot be found are left
+// without a name rather than failing the whole page.
+func (r *ReviewService) usernames(ctx context.Context, userIds []string) map[string]string {
+	users, err := r.lookups.users.Get(ctx, userIds)
+	if err != nil {
+		r.log.Info("failed to get usernames of reviewers ", zap.Error(err))
+	}
+
+	names := map[string]string{}
+	for id, user := range users {
+		names[id] = user.Username
+	}
+	return names
+}
+
+func (r *ReviewService) GetRatingStats(ctx context.Context, id *pb.Id) (*pb.RatingStats, error) {
+	stats, err := r.reviewRepo.GetRatingStats(ctx, id.Id)
+	if err != nil {
+		r.log.Error("failed to get rating stats of kitchen ", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.RatingStats{
+		KitchenId:              id.Id,
+		Total:                  int64(stats.TotalNumberOfComments),
+		AverageRating:          stats.AvarageRating,
+		Histogram:              stats.Histogram[:],
+		MonthlyAverageRating:   stats.Average30Days,
+		QuarterlyAverageRating: stats.Average90Days,
+		BayesianRating:         stats.BayesianRating,
+	}, nil
+}
+
+func (r *ReviewService) getReview(ctx context.Context, id string) (*pb.ReviewInfo, error) {
+	res, err := r.reviewRepo.GetReviewById(ctx, id)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "review %s not found", id)
+	}
+	if err != nil {
+		r.log.Error("failed to get review by id ", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// attachDetails adds the reply and the edit history to the review.
+func (r *ReviewService) attachDetails(ctx context.Context, review *pb.ReviewInfo) error {
+	reply, err := r.reviewRepo.GetReply(ctx, review.Id)
+	if err != nil && err != sql.ErrNoRows {
+		r.log.Error("failed to get reply of review ", zap.Error(err))
+		return err
+	}
+	review.Reply = reply
+
+	review.Edits, err = r.reviewRepo.GetReviewEdits(ctx, review.Id)
+	if err != nil {
+		r.log.Error("failed to get edits of review ", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"order_service/models"
+	"order_service/pkg/eta"
+	"order_service/pkg/lifecycle"
+	"order_service/storage/postgres"
+	"time"
+
+	pb "order_service/genproto/order"
+
+	"go.uber.org/zap"
+)
+
+// releaseBatch bounds the orders released in one run of the scheduler.
+const releaseBatch = 100
+
+// OrderScheduler releases scheduled orders to the kitchen queue once their
+// delivery time is within the lead time. Several instances may run at once;
+// the status check of UpdateOrderStatus releases every order only once.
+type OrderScheduler struct {
+	orderRepo *postgres.OrderRepo
+	estimator eta.Estimator
+	events    *orderEvents
+	leadTime  time.Duration
+	interval  time.Duration
+	log       *zap.Logger
+}
+
+func NewOrderScheduler(sysConfig *models.SystemConfig) *OrderScheduler {
+	return &OrderScheduler{
+		orderRepo: postgres.NewOrderRepo(sysConfig.PostgresDb),
+		estimator: newEstimator(sysConfig),
+		events:    newOrderEvents(sysConfig),
+		leadTime:  sysConfig.Config.SCHEDULE_LEAD_TIME,
+		interval:  sysConfig.Config.SCHEDULER_INTERVAL,
+		log:       sysConfig.Logger,
+	}
+}
+
+// Run releases due orders every interval until ctx is done.
+func (s *OrderScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.release(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *OrderScheduler) release(ctx context.Context, now time.Time) {
+	orders, err := s.orderRepo.GetDueScheduledOrders(ctx, now.Add(s.leadTime), releaseBatch)
+	if err != nil {
+		s.log.Error("failed to get due scheduled orders ", zap.Error(err))
+		return
+	}
+
+	released := 0
+	for _, order := range orders {
+		next := lifecycle.Pending
+		if order.Paid {
+			next = lifecycle.Paid
+		}
+
+		_, err := s.orderRepo.UpdateOrderStatus(ctx, &pb.Status{Id: order.Id, Status: next}, lifecycle.Scheduled, "")
+		if err == sql.ErrNoRows {
+			// Cancelled or released by another instance meanwhile.
+			continue
+		}
+		if err != nil {
+			s.log.Error("failed to release scheduled order ", zap.String("order_id", order.Id), zap.Error(err))
+			continue
+		}
+		estimate := refreshEta(ctx, s.orderRepo, s.estimator, order.Id, s.log)
+		s.events.publish(ctx, models.OrderEventStatusChanged,
+			&pb.OrderInfo{Id: order.Id, KitchenId: order.KitchenId, UserId: order.UserId}, next, estimate)
+		released++
+	}
+
+	if released > 0 {
+		s.log.Info("released scheduled orders ", zap.Int("count", released))
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"fmt"
+	"order_service/models"
+	"order_service/pkg/money"
+	"sort"
+
+	pb "order_service/genproto/order"
+)
+
+// busiestHours merges the buckets of every day of the week into hours of the
+// day, busiest first.
+func busiestHours(buckets []models.HourBucket) ([]*pb.HourStats, error) {
+	hours := map[int]*models.HourBucket{}
+	for _, b := range buckets {
+		hour, ok := hours[b.Hour]
+		if !ok {
+			hour = &models.HourBucket{Hour: b.Hour, Revenue: money.New(0, b.Revenue.Currency)}
+			hours[b.Hour] = hour
+		}
+
+		revenue, err := hour.Revenue.Add(b.Revenue)
+		if err != nil {
+			return nil, err
+		}
+		hour.Orders += b.Orders
+		hour.Revenue = revenue
+	}
+
+	merged := make([]models.HourBucket, 0, len(hours))
+	for _, hour := range hours {
+		merged = append(merged, *hour)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		a, b := merged[i], merged[j]
+		if a.Orders != b.Orders {
+			return a.Orders > b.Orders
+		}
+		if a.Revenue.Amount != b.Revenue.Amount {
+			return a.Revenue.Amount > b.Revenue.Amount
+		}
+		return a.Hour < b.Hour
+	})
+
+	stats := make([]*pb.HourStats, 0, len(merged))
+	for _, hour := range merged {
+		stats = append(stats, hourStats(hour, false))
+	}
+	return stats, nil
+}
+
+// heatmap returns one cell per day of the week and hour with orders, in
+// calendar order starting on Sunday.
+func heatmap(buckets []models.HourBucket) []*pb.HourStats {
+	cells := append([]models.HourBucket(nil), buckets...)
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].Weekday != cells[j].Weekday {
+			return cells[i].Weekday < cells[j].Weekday
+		}
+		return cells[i].Hour < cells[j].Hour
+	})
+
+	stats := make([]*pb.HourStats, 0, len(cells))
+	for _, cell := range cells {
+		stats = append(stats, hourStats(cell, true))
+	}
+	return stats
+}
+
+func hourStats(b models.HourBucket, withDay bool) *pb.HourStats {
+	stats := &pb.HourStats{
+		Hour:         fmt.Sprintf("%02d:00", b.Hour),
+		OrdersCount:  int32(b.Orders),
+		Revenue:      float32(b.Revenue.Float()),
+		RevenueMoney: &pb.Money{Amount: b.Revenue.Amount, Currency: b.Revenue.Currency},
+	}
+	if withDay {
+		stats.DayOfWeek = int32(b.Weekday)
+	}
+	return stats
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"database/sql"
+	"order_service/models"
+
+	pb "order_service/genproto/order"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchOrder streams the status and delivery estimate changes of an order to
+// its customer or its kitchen, starting with the current state.
+func (o *OrderService) WatchOrder(id *pb.Id, stream pb.Order_WatchOrderServer) error {
+	ctx := stream.Context()
+
+	order, err := o.orderRepo.GetOrderById(ctx, id.Id)
+	if err == sql.ErrNoRows {
+		return status.Errorf(codes.NotFound, "order %s not found", id.Id)
+	}
+	if err != nil {
+		o.log.Error("failed to get order by id for watch ", zap.Error(err))
+		return err
+	}
+	if err := authorizeOrder(ctx, o.kitchenClient, order); err != nil {
+		return err
+	}
+
+	snapshot := func() error {
+		current, err := o.orderRepo.GetOrderById(ctx, id.Id)
+		if err != nil {
+			o.log.Error("failed to get order snapshot for watch ", zap.Error(err))
+			return err
+		}
+		return stream.Send(&pb.OrderEvent{
+			Type:                  models.OrderEventSnapshot,
+			OrderId:               current.Id,
+			KitchenId:             current.KitchenId,
+			UserId:                current.UserId,
+			Status:                current.Status,
+			EstimatedDeliveryTime: current.EstimatedDeliveryTime,
+			OccurredAt:            current.UpdatedAt,
+		})
+	}
+
+	return o.events.watch(ctx, orderChannelPrefix+order.Id, snapshot, stream.Send)
+}
+
+// WatchKitchenOrders streams new and changed orders of a kitchen to the
+// dashboard of its chef.
+func (o *OrderService) WatchKitchenOrders(id *pb.Id, stream pb.Order_WatchKitchenOrdersServer) error {
+	return o.events.watch(stream.Context(), kitchenChannelPrefix+id.Id, nil, stream.Send)
+}
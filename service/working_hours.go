@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"order_service/pkg/workinghours"
+	"time"
+
+	pbk "order_service/genproto/kitchen"
+	pb "order_service/genproto/order"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (o *OrderService) ManageWorkingHours(ctx context.Context, id *pb.Id) (*pb.WorkingHours, error) {
+	res, err := o.workingHoursRepo.GetWorkingHours(ctx, id.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "kitchen %s has no working hours", id.Id)
+	}
+	if err != nil {
+		o.log.Error("failed to get working hours ", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.WorkingHours{
+		KitchenId: res.KitchenId,
+		Monday:    res.Monday,
+		Tuesday:   res.Tuesday,
+		Wednesday: res.Wednesday,
+		Thursday:  res.Thursday,
+		Friday:    res.Friday,
+		Saturday:  res.Saturday,
+		Sunday:    res.Sunday,
+	}, nil
+}
+
+func (o *OrderService) CreateWorkingHours(ctx context.Context, hours *pb.WorkingHours) (*pb.WorkingHoursRes, error) {
+	if err := o.validateWorkingHours(ctx, hours); err != nil {
+		return nil, err
+	}
+
+	_, err := o.workingHoursRepo.GetWorkingHours(ctx, hours.KitchenId)
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "kitchen %s already has working hours", hours.KitchenId)
+	}
+	if err != sql.ErrNoRows {
+		o.log.Error("failed to get working hours ", zap.Error(err))
+		return nil, err
+	}
+
+	res, err := o.workingHoursRepo.CreateWorkingHours(ctx, hours)
+	if err != nil {
+		o.log.Error("failed to create working hours ", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (o *OrderService) UpdateWorkingHours(ctx context.Context, hours *pb.WorkingHours) (*pb.WorkingHoursRes, error) {
+	if err := o.validateWorkingHours(ctx, hours); err != nil {
+		return nil, err
+	}
+
+	res, err := o.workingHoursRepo.UpdateWorkingHours(ctx, hours)
+	if err != nil {
+		o.log.Error("failed to update working hours ", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (o *OrderService) DeleteWorkingHours(ctx context.Context, id *pb.Id) (*pb.Void, error) {
+	err := o.workingHoursRepo.DeleteWorkingHours(ctx, id.Id)
+	if err != nil {
+		o.log.Error("failed to delete working hours ", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.Void{}, nil
+}
+
+func (o *OrderService) CreateWorkingHoursOverride(ctx context.Context, override *pb.WorkingHoursOverride) (*pb.WorkingHoursOverride, error) {
+	_, err := o.kitchenClient.ValidateKitchenId(ctx, &pbk.Id{Id: override.KitchenId})
+	if err != nil {
+		o.log.Info("invalid kitchen id ", zap.Error(err))
+		return nil, err
+	}
+	if _, err := workinghours.ParseDate(override.Date); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !override.IsClosed {
+		if _, err := workinghours.ParsePeriod(override.Open, override.Close); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	res, err := o.workingHoursRepo.CreateOverride(ctx, override)
+	if err != nil {
+		o.log.Error("failed to create working hours override ", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (o *OrderService) GetWorkingHoursOverrides(ctx context.Context, id *pb.Id) (*pb.WorkingHoursOverrides, error) {
+	res, err := o.workingHoursRepo.GetOverrides(ctx, id.Id)
+	if err != nil {
+		o.log.Error("failed to get working hours overrides ", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (o *OrderService) DeleteWorkingHoursOverride(ctx context.Context, id *pb.Id) (*pb.Void, error) {
+	override, err := o.workingHoursRepo.GetOverrideById(ctx, id.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "working hours override %s not found", id.Id)
+	}
+	if err != nil {
+		o.log.Error("failed to get working hours override ", zap.Error(err))
+		return nil, err
+	}
+	if err := authorizeKitchen(ctx, o.kitchenClient, override.KitchenId); err != nil {
+		return nil, err
+	}
+
+	err = o.workingHoursRepo.DeleteOverride(ctx, id.Id)
+	if err != nil {
+		o.log.Error("failed to delete working hours override ", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.Void{}, nil
+}
+
+func (o *OrderService) validateWorkingHours(ctx context.Context, hours *pb.WorkingHours) error {
+	_, err := o.kitchenClient.ValidateKitchenId(ctx, &pbk.Id{Id: hours.KitchenId})
+	if err != nil {
+		o.log.Info("invalid kitchen id ", zap.Error(err))
+		return err
+	}
+
+	workDays := 0
+	for _, day := range []*pb.WorkingHoursOfDay{hours.Monday, hours.Tuesday, hours.Wednesday, hours.Thursday,
+		hours.Friday, hours.Saturday, hours.Sunday} {
+		if day == nil || !day.IsWorkDay {
+			continue
+		}
+		if _, err := workinghours.ParsePeriod(day.Open, day.Close); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		workDays++
+	}
+	if workDays == 0 {
+		return status.Error(codes.InvalidArgument, "at least one work day is required")
+	}
+
+	return nil
+}
+
+// kitchenSchedule loads the weekly hours and overrides of a kitchen.
+func (o *OrderService) kitchenSchedule(ctx context.Context, kitchenId string) (workinghours.Schedule, error) {
+	schedule := workinghours.Schedule{Days: map[time.Weekday]workinghours.Period{}}
+
+	hours, err := o.workingHoursRepo.GetWorkingHours(ctx, kitchenId)
+	if err != nil && err != sql.ErrNoRows {
+		return schedule, err
+	}
+	if hours != nil {
+		week := map[time.Weekday]*pb.WorkingHoursOfDay{
+			time.Sunday:    hours.Sunday,
+			time.Monday:    hours.Monday,
+			time.Tuesday:   hours.Tuesday,
+			time.Wednesday: hours.Wednesday,
+			time.Thursday:  hours.Thursday,
+			time.Friday:    hours.Friday,
+			time.Saturday:  hours.Saturday,
+		}
+		for day, h := range week {
+			if !h.IsWorkDay {
+				continue
+			}
+			period, err := workinghours.ParsePeriod(h.Open, h.Close)
+			if err != nil {
+				return schedule, err
+			}
+			schedule.Days[day] = period
+		}
+	}
+
+	overrides, err := o.workingHoursRepo.GetOverrides(ctx, kitchenId)
+	if err != nil {
+		return schedule, err
+	}
+	for _, ov := range overrides.Overrides {
+		date, err := workinghours.ParseDate(ov.Date)
+		if err != nil {
+			return schedule, err
+		}
+		override := workinghours.Override{Date: date, Closed: ov.IsClosed, RepeatsYearly: ov.RepeatsYearly}
+		if !ov.IsClosed {
+			override.Period, err = workinghours.ParsePeriod(ov.Open, ov.Close)
+			if err != nil {
+				return schedule, err
+			}
+		}
+		schedule.Overrides = append(schedule.Overrides, override)
+	}
+
+	return schedule, nil
+}
+
+// checkKitchenOpen rejects orders for a kitchen that is closed at the given
+// time. Kitchens that never configured working hours are always open.
+func (o *OrderService) checkKitchenOpen(ctx context.Context, kitchenId string, at time.Time) error {
+	schedule, err := o.kitchenSchedule(ctx, kitchenId)
+	if err != nil {
+		o.log.Error("failed to get working hours of kitchen ", zap.Error(err))
+		return err
+	}
+	if schedule.IsEmpty() {
+		return nil
+	}
+	if !schedule.IsOpen(at.In(o.location)) {
+		return status.Errorf(codes.FailedPrecondition, "kitchen %s is closed at %s", kitchenId,
+			at.In(o.location).Format(time.RFC3339))
+	}
+
+	return nil
+}
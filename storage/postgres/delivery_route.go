@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	pb "order_service/genproto/delivery"
+	"order_service/pkg/routing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ErrRouteExists is returned when the order already has a delivery route.
+var ErrRouteExists = errors.New("order already has a delivery route")
+
+type DeliveryRouteRepo struct {
+	Db *sql.DB
+}
+
+func NewDeliveryRouteRepo(db *sql.DB) *DeliveryRouteRepo {
+	return &DeliveryRouteRepo{Db: db}
+}
+
+// CreateRoute stores the route of an order. The delivery time of the route is
+// the one booked with the order, if any; the route-based estimate is kept in
+// orders.estimated_delivery_time. A second live route of the same order returns
+// ErrRouteExists.
+func (d *DeliveryRouteRepo) CreateRoute(ctx context.Context, req *pb.ReqRoute, route *routing.Route) (*pb.RouteInfo, error) {
+	query := `
+	with route as (
+		insert into
+			delivery_routes(
+			id,
+			order_id,
+			start_address,
+			end_address,
+			distance,
+			duration,
+			route_polyline,
+			waypoints,
+			created_at,
+			updated_at)
+		values($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		returning order_id
+	)
+	select
+		o.delivery_time
+	from
+		route
+	join
+		orders o on o.id = route.order_id
+	`
+
+	currentTime := time.Now().Format(time.RFC3339)
+	res := newRouteInfo(req, route)
+	res.Id = uuid.NewString()
+	res.CreatedAt = currentTime
+	res.UpdatedAt = currentTime
+
+	waypoints, err := json.Marshal(route.Waypoints)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveryTime sql.NullString
+	err = d.Db.QueryRowContext(ctx, query, res.Id, res.OrderId, res.StartAddress, res.EndAddress, res.Distance,
+		res.Duration, res.RoutePolyline, string(waypoints), res.CreatedAt, res.UpdatedAt).Scan(&deliveryTime)
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		return nil, ErrRouteExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.DeliveryTime = deliveryTime.String
+
+	return res, nil
+}
+
+// UpdateRoute replaces the route of an order. It returns sql.ErrNoRows when the
+// order has no route.
+func (d *DeliveryRouteRepo) UpdateRoute(ctx context.Context, req *pb.ReqRoute, route *routing.Route) (*pb.RouteInfo, error) {
+	query := `
+	with route as (
+		update
+			delivery_routes
+		set
+			start_address = $1,
+			end_address = $2,
+			distance = $3,
+			duration = $4,
+			route_polyline = $5,
+			waypoints = $6,
+			updated_at = now()
+		where
+			order_id = $7 and deleted_at is null
+		returning id, order_id, created_at, updated_at
+	)
+	select
+		route.id, o.delivery_time, route.created_at, route.updated_at
+	from
+		route
+	join
+		orders o on o.id = route.order_id
+	`
+
+	res := newRouteInfo(req, route)
+
+	waypoints, err := json.Marshal(route.Waypoints)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveryTime sql.NullString
+	err = d.Db.QueryRowContext(ctx, query, res.StartAddress, res.EndAddress, res.Distance, res.Duration,
+		res.RoutePolyline, string(waypoints), res.OrderId).Scan(&res.Id, &deliveryTime, &res.CreatedAt, &res.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	res.DeliveryTime = deliveryTime.String
+
+	return res, nil
+}
+
+// GetRouteByOrderId returns the route of an order with the booked delivery
+// time of the order or, without one, its estimate.
+func (d *DeliveryRouteRepo) GetRouteByOrderId(ctx context.Context, orderId string) (*pb.RouteInfo, error) {
+	query := `
+	select
+		r.id,
+		r.order_id,
+		r.start_address,
+		r.end_address,
+		r.distance,
+		r.duration,
+		coalesce(r.route_polyline, ''),
+		coalesce(r.waypoints, '[]'),
+		coalesce(o.delivery_time, o.estimated_delivery_time),
+		r.created_at,
+		r.updated_at
+	from
+		delivery_routes r
+	join
+		orders o on o.id = r.order_id
+	where
+		r.order_id = $1 and r.deleted_at is null
+	`
+
+	res := &pb.RouteInfo{}
+	waypoints := ""
+	var deliveryTime sql.NullString
+	err := d.Db.QueryRowContext(ctx, query, orderId).Scan(&res.Id, &res.OrderId, &res.StartAddress, &res.EndAddress,
+		&res.Distance, &res.Duration, &res.RoutePolyline, &waypoints, &deliveryTime, &res.CreatedAt, &res.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	res.DeliveryTime = deliveryTime.String
+
+	points := []routing.Point{}
+	err = json.Unmarshal([]byte(waypoints), &points)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range points {
+		res.Waypoints = append(res.Waypoints, &pb.Location{Latitude: p.Latitude, Longitude: p.Longitude})
+	}
+
+	return res, nil
+}
+
+func newRouteInfo(req *pb.ReqRoute, route *routing.Route) *pb.RouteInfo {
+	res := &pb.RouteInfo{
+		OrderId:       req.OrderId,
+		StartAddress:  req.StartAddress,
+		EndAddress:    req.EndAddress,
+		Distance:      route.Distance,
+		Duration:      int32(route.Duration.Seconds()),
+		RoutePolyline: route.Polyline,
+	}
+	for _, p := range route.Waypoints {
+		res.Waypoints = append(res.Waypoints, &pb.Location{Latitude: p.Latitude, Longitude: p.Longitude})
+	}
+
+	return res
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	pb "order_service/genproto/delivery"
+	"order_service/pkg/routing"
+	"testing"
+)
+
+func newDeliveryRouteRepo() *DeliveryRouteRepo {
+	db, err := ConnectDB()
+	if err != nil {
+		panic(err)
+	}
+
+	return &DeliveryRouteRepo{Db: db}
+}
+
+func TestCreateRoute(t *testing.T) {
+	d := newDeliveryRouteRepo()
+
+	req := &pb.ReqRoute{
+		OrderId:      "6e5c785c-d427-4ab5-afac-ed00400c08c7",
+		StartAddress: "Amir Temur square",
+		EndAddress:   "Chorsu bazaar",
+		Start:        &pb.Location{Latitude: 41.3111, Longitude: 69.2797},
+		End:          &pb.Location{Latitude: 41.3265, Longitude: 69.2353},
+	}
+	from := routing.Point{Latitude: req.Start.Latitude, Longitude: req.Start.Longitude}
+	to := routing.Point{Latitude: req.End.Latitude, Longitude: req.End.Longitude}
+	route, err := routing.NewHaversine().Route(context.Background(), from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := d.CreateRoute(context.Background(), req, route)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.DeliveryTime == "" {
+		t.Error("expected delivery time to be derived from the route")
+	}
+}
+
+func TestGetRouteByOrderId(t *testing.T) {
+	d := newDeliveryRouteRepo()
+
+	_, err := d.GetRouteByOrderId(context.Background(), "6e5c785c-d427-4ab5-afac-ed00400c08c7")
+	if err != nil {
+		t.Error(err)
+	}
+}
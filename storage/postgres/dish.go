@@ -7,6 +7,7 @@ import (
 	"fmt"
 	pb "order_service/genproto/dish"
 	pbu "order_service/genproto/user"
+	"order_service/pkg/money"
 	"strings"
 	"time"
 
@@ -22,7 +23,7 @@ func NewDishRepo(db *sql.DB) *DishRepo {
 	return &DishRepo{Db: db}
 }
 
-func (d *DishRepo) CreateDish(ctx context.Context, dish *pb.ReqCreateDish) (*pb.DishInfo, error) {
+func (d *DishRepo) CreateDish(ctx context.Context, dish *pb.ReqCreateDish, price money.Money) (*pb.DishInfo, error) {
 	query := `
 	insert into
 		dishes(
@@ -35,28 +36,32 @@ func (d *DishRepo) CreateDish(ctx context.Context, dish *pb.ReqCreateDish) (*pb.
 			ingredients,
 			available,
 			created_at,
-			updated_at
+			updated_at,
+			currency,
+			prep_time_minutes
 			)
-	values($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	values($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 	`
 
 	res := &pb.DishInfo{
-		Id:            uuid.NewString(),
-		KitchenId:     dish.KitchenId,
-		Name:          dish.Name,
-		Price:         dish.Price,
-		Category:      dish.Category,
-		Ingredients:   dish.Ingredients,
-		Description:   dish.Description,
-		Available:     dish.Available,
-		Allergens:     []string{},
-		NutritionInfo: "",
-		CreatedAt:     time.Now().Format(time.RFC3339),
-		UpdatedAt:     time.Now().Format(time.RFC3339),
+		Id:              uuid.NewString(),
+		KitchenId:       dish.KitchenId,
+		Name:            dish.Name,
+		Price:           float32(price.Float()),
+		PriceMoney:      &pb.Money{Amount: price.Amount, Currency: price.Currency},
+		Category:        dish.Category,
+		Ingredients:     dish.Ingredients,
+		Description:     dish.Description,
+		Available:       dish.Available,
+		Allergens:       []string{},
+		NutritionInfo:   "",
+		PrepTimeMinutes: dish.PrepTimeMinutes,
+		CreatedAt:       time.Now().Format(time.RFC3339),
+		UpdatedAt:       time.Now().Format(time.RFC3339),
 	}
 
-	_, err := d.Db.ExecContext(ctx, query, res.Id, res.KitchenId, res.Name, res.Description, res.Price, res.Category,
-		pq.Array(res.Ingredients), res.Available, res.CreatedAt, res.UpdatedAt)
+	_, err := d.Db.ExecContext(ctx, query, res.Id, res.KitchenId, res.Name, res.Description, price.String(), res.Category,
+		pq.Array(res.Ingredients), res.Available, res.CreatedAt, res.UpdatedAt, price.Currency, res.PrepTimeMinutes)
 
 	if err != nil {
 		return nil, err
@@ -65,7 +70,7 @@ func (d *DishRepo) CreateDish(ctx context.Context, dish *pb.ReqCreateDish) (*pb.
 	return res, nil
 }
 
-func (d *DishRepo) UpdateDish(ctx context.Context, dish *pb.ReqUpdateDish) (*pb.DishInfo, error) {
+func (d *DishRepo) UpdateDish(ctx context.Context, dish *pb.ReqUpdateDish, price money.Money) (*pb.DishInfo, error) {
 	query := `
 	update
 		dishes
@@ -76,23 +81,30 @@ func (d *DishRepo) UpdateDish(ctx context.Context, dish *pb.ReqUpdateDish) (*pb.
 		category = $4,
 		ingredients = $5,
 		available = $6,
+		currency = $7,
+		prep_time_minutes = coalesce(nullif($9, 0), prep_time_minutes),
 		updated_at = now()
 	where
-		id = $7 and deleted_at is null
-	returning id, kitchen_id, name, description, price, category, ingredients, allergens, nutrition_info, dietary_info, available,
-	created_at, updated_at
+		id = $8 and deleted_at is null
+	returning id, kitchen_id, name, description, price, currency, category, ingredients, allergens, nutrition_info,
+	dietary_info, available, prep_time_minutes, created_at, updated_at
 	`
 
 	res := &pb.DishInfo{}
 
-	row := d.Db.QueryRowContext(ctx, query, dish.Name, dish.Description, dish.Price, dish.Category, pq.Array(dish.Ingredients),
-		dish.Available, dish.Id)
+	row := d.Db.QueryRowContext(ctx, query, dish.Name, dish.Description, price.String(), dish.Category, pq.Array(dish.Ingredients),
+		dish.Available, price.Currency, dish.Id, dish.PrepTimeMinutes)
 
 	var nutritionInfo sql.NullString
-	err := row.Scan(&res.Id, &res.KitchenId, &res.Name, &res.Description, &res.Price, &res.Category, pq.Array(&res.Ingredients),
-		pq.Array(&res.Allergens), &nutritionInfo, pq.Array(&res.DietaryInfo), &res.Available, &res.CreatedAt, &res.UpdatedAt)
-
+	var amount, currency string
+	err := row.Scan(&res.Id, &res.KitchenId, &res.Name, &res.Description, &amount, &currency, &res.Category,
+		pq.Array(&res.Ingredients), pq.Array(&res.Allergens), &nutritionInfo, pq.Array(&res.DietaryInfo), &res.Available,
+		&res.PrepTimeMinutes, &res.CreatedAt, &res.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
 	res.NutritionInfo = nutritionInfo.String
+	res.Price, res.PriceMoney, err = dishPrice(amount, currency)
 
 	return res, err
 }
@@ -100,7 +112,7 @@ func (d *DishRepo) UpdateDish(ctx context.Context, dish *pb.ReqUpdateDish) (*pb.
 func (d *DishRepo) GetDishes(ctx context.Context, pagination *pb.Pagination) (*pb.Dishes, error) {
 	query := `
 	select
-		id, kitchen_id, price, category, available
+		id, kitchen_id, price, currency, category, available
 	from
 		dishes
 	where
@@ -117,7 +129,12 @@ func (d *DishRepo) GetDishes(ctx context.Context, pagination *pb.Pagination) (*p
 	dishes := pb.Dishes{}
 	for rows.Next() {
 		dish := pb.DishShortInfo{}
-		err := rows.Scan(&dish.Id, &dish.KitchenId, &dish.Price, &dish.Category, &dish.Available)
+		var amount, currency string
+		err := rows.Scan(&dish.Id, &dish.KitchenId, &amount, &currency, &dish.Category, &dish.Available)
+		if err != nil {
+			return nil, err
+		}
+		dish.Price, dish.PriceMoney, err = dishPrice(amount, currency)
 		if err != nil {
 			return nil, err
 		}
@@ -130,8 +147,8 @@ func (d *DishRepo) GetDishes(ctx context.Context, pagination *pb.Pagination) (*p
 func (d *DishRepo) GetDishById(ctx context.Context, id *pb.Id) (*pb.DishInfo, error) {
 	query := `
 	select
-		id, kitchen_id, name, description, price, category, ingredients, allergens, nutrition_info, dietary_info, available,
-	created_at, updated_at
+		id, kitchen_id, name, description, price, currency, category, ingredients, allergens, nutrition_info, dietary_info,
+	available, prep_time_minutes, created_at, updated_at
 	from
 		dishes
 	where
@@ -143,17 +160,80 @@ func (d *DishRepo) GetDishById(ctx context.Context, id *pb.Id) (*pb.DishInfo, er
 	dish := &pb.DishInfo{}
 
 	var nutritionInfo sql.NullString
-	err := row.Scan(&dish.Id, &dish.KitchenId, &dish.Name, &dish.Description, &dish.Price,
+	var amount, currency string
+	err := row.Scan(&dish.Id, &dish.KitchenId, &dish.Name, &dish.Description, &amount, &currency,
 		&dish.Category, pq.Array(&dish.Ingredients), pq.Array(&dish.Allergens), &nutritionInfo, pq.Array(&dish.DietaryInfo),
-		&dish.Available, &dish.CreatedAt, &dish.UpdatedAt)
+		&dish.Available, &dish.PrepTimeMinutes, &dish.CreatedAt, &dish.UpdatedAt)
 	if err != nil {
 		return nil, err
 	}
 	dish.NutritionInfo = nutritionInfo.String
+	dish.Price, dish.PriceMoney, err = dishPrice(amount, currency)
+	if err != nil {
+		return nil, err
+	}
 
 	return dish, row.Err()
 }
 
+// GetDishesByIds returns the non-deleted dishes with the given ids keyed by id.
+func (d *DishRepo) GetDishesByIds(ctx context.Context, ids []string) (map[string]*pb.DishInfo, error) {
+	return selectDishes(ctx, d.Db, ids, "")
+}
+
+// selectDishes reads the non-deleted dishes with the given ids keyed by id.
+// lock is an optional locking clause such as "for share".
+func selectDishes(ctx context.Context, db querier, ids []string, lock string) (map[string]*pb.DishInfo, error) {
+	query := `
+	select
+		id, kitchen_id, name, price, currency, available
+	from
+		dishes
+	where
+		deleted_at is null and id = any($1)
+	` + lock
+
+	rows, err := db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dishes := map[string]*pb.DishInfo{}
+	for rows.Next() {
+		dish := &pb.DishInfo{}
+		var amount, currency string
+		err := rows.Scan(&dish.Id, &dish.KitchenId, &dish.Name, &amount, &currency, &dish.Available)
+		if err != nil {
+			return nil, err
+		}
+		dish.Price, dish.PriceMoney, err = dishPrice(amount, currency)
+		if err != nil {
+			return nil, err
+		}
+		dishes[dish.Id] = dish
+	}
+
+	return dishes, rows.Err()
+}
+
+// GetDishKitchenId returns the kitchen of a dish that is not deleted.
+func (d *DishRepo) GetDishKitchenId(ctx context.Context, id string) (string, error) {
+	query := `
+	select
+		kitchen_id
+	from
+		dishes
+	where
+		id = $1 and deleted_at is null
+	`
+
+	kitchenId := ""
+	err := d.Db.QueryRowContext(ctx, query, id).Scan(&kitchenId)
+
+	return kitchenId, err
+}
+
 func (d *DishRepo) DeleteDish(ctx context.Context, id string) error {
 	query := `
 	update
@@ -202,8 +282,8 @@ func (d *DishRepo) UpdateNutritionInfo(ctx context.Context, info *pb.NutritionIn
 		updated_at = now()
 	where
 		id = $4 and deleted_at is null
-	returning id, kitchen_id, name, description, price, category, ingredients, allergens, 
-	nutrition_info, dietary_info, available, created_at, updated_at
+	returning id, kitchen_id, name, description, price, currency, category, ingredients, allergens, 
+	nutrition_info, dietary_info, available, prep_time_minutes, created_at, updated_at
 	`
 
 	res := &pb.DishInfo{}
@@ -220,10 +300,15 @@ func (d *DishRepo) UpdateNutritionInfo(ctx context.Context, info *pb.NutritionIn
 	row := d.Db.QueryRowContext(ctx, query, pq.Array(info.Allergens), string(data), pq.Array(info.DietaryInfo), info.Id)
 
 	var nutritionInfo sql.NullString
-	err = row.Scan(&res.Id, &res.KitchenId, &res.Name, &res.Description, &res.Price, &res.Category, pq.Array(&res.Ingredients),
-		pq.Array(&res.Allergens), &nutritionInfo, pq.Array(&res.DietaryInfo), &res.Available, &res.CreatedAt, &res.UpdatedAt)
-
+	var amount, currency string
+	err = row.Scan(&res.Id, &res.KitchenId, &res.Name, &res.Description, &amount, &currency, &res.Category,
+		pq.Array(&res.Ingredients), pq.Array(&res.Allergens), &nutritionInfo, pq.Array(&res.DietaryInfo), &res.Available,
+		&res.PrepTimeMinutes, &res.CreatedAt, &res.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
 	res.NutritionInfo = nutritionInfo.String
+	res.Price, res.PriceMoney, err = dishPrice(amount, currency)
 
 	return res, err
 }
@@ -237,7 +322,7 @@ func (d *DishRepo) RecommendDishes(ctx context.Context, filter *pb.Filter, user
 
 	query := `
 	select
-		id, kitchen_id, price, category, available
+		id, kitchen_id, price, currency, category, available
 	from
 		dishes
 	where
@@ -261,7 +346,12 @@ func (d *DishRepo) RecommendDishes(ctx context.Context, filter *pb.Filter, user
 	dishes := pb.Recommendations{}
 	for rows.Next() {
 		dish := pb.DishShortInfo{}
-		err := rows.Scan(&dish.Id, &dish.KitchenId, &dish.Price, &dish.Category, &dish.Available)
+		var amount, currency string
+		err := rows.Scan(&dish.Id, &dish.KitchenId, &amount, &currency, &dish.Category, &dish.Available)
+		if err != nil {
+			return nil, err
+		}
+		dish.Price, dish.PriceMoney, err = dishPrice(amount, currency)
 		if err != nil {
 			return nil, err
 		}
@@ -298,3 +388,14 @@ func (d *DishRepo) GetTotalRecommendation(ctx context.Context, filter *pb.Filter
 
 	return total, nil
 }
+
+// dishPrice converts a DECIMAL price column into the legacy float price and
+// the exact money price of the API.
+func dishPrice(amount, currency string) (float32, *pb.Money, error) {
+	price, err := money.Parse(amount, currency)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return float32(price.Float()), &pb.Money{Amount: price.Amount, Currency: price.Currency}, nil
+}
@@ -112,3 +112,12 @@ func TestRecommendDishes(t *testing.T) {
 		t.Fatalf("Expected to find recommended dishes, but got none")
 	}
 }
+
+func TestGetDishesByIds(t *testing.T) {
+	d := newDishRepo()
+
+	_, err := d.GetDishesByIds(context.Background(), []string{"cdffffd7-67f2-4f96-b0b3-6d6b6bb85724"})
+	if err != nil {
+		t.Error(err)
+	}
+}
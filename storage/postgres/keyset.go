@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"fmt"
+	"order_service/pkg/cursor"
+)
+
+// pageCursor holds the sort keys of the last row of a page that every listing
+// shares, and the sort the cursor was issued for.
+type pageCursor struct {
+	Sort      string `json:"sort"`
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+}
+
+func (c *pageCursor) sortName() string {
+	return c.Sort
+}
+
+// decodeCursor reads the cursor of an earlier page into c. A cursor issued for
+// another sort lacks the keys of this one and is rejected with
+// cursor.ErrInvalid.
+func decodeCursor(encoded, sort string, c interface{ sortName() string }) error {
+	if err := cursor.Decode(encoded, c); err != nil {
+		return err
+	}
+	if c.sortName() != sort {
+		return cursor.ErrInvalid
+	}
+	return nil
+}
+
+// keyset is one sort of a listing paged with cursors of type C: the order by
+// clause, the condition selecting the rows after a cursor, written with
+// indexed verbs (%[1]d, %[2]d, ...) for the placeholders of its keys, and the
+// keys themselves.
+type keyset[C any] struct {
+	orderBy string
+	after   string
+	keys    func(c *C) []any
+}
+
+// page appends the sort and paging to a query ending in a where clause with
+// args as its arguments. A cursor takes precedence over the page number. One
+// extra row is fetched to tell whether there is a next page.
+func (k keyset[C]) page(query string, args []any, after *C, page, limit int32) (string, []any) {
+	if after != nil {
+		keys := k.keys(after)
+		placeholders := make([]any, len(keys))
+		for i := range keys {
+			placeholders[i] = len(args) + i + 1
+		}
+		query += " and " + fmt.Sprintf(k.after, placeholders...)
+		args = append(args, keys...)
+	}
+	query += " order by " + k.orderBy
+	if after == nil && page > 1 {
+		query += fmt.Sprintf(" offset %d", (page-1)*limit)
+	}
+	query += fmt.Sprintf(" limit %d", limit+1)
+
+	return query, args
+}
@@ -4,14 +4,31 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	pb "order_service/genproto/order"
 	"order_service/models"
+	"order_service/pkg/cursor"
+	"order_service/pkg/eta"
+	"order_service/pkg/lifecycle"
+	"order_service/pkg/money"
+	"order_service/pkg/pricing"
+	"order_service/pkg/scheduling"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+// ErrSlotFull is returned when the delivery slot of an order already holds as
+// many orders as a kitchen accepts per slot.
+var ErrSlotFull = errors.New("delivery slot is full")
+
+// ErrInvalidItems is returned when the items of a new order do not match the
+// menu of its kitchen.
+var ErrInvalidItems = errors.New("invalid order items")
+
 type OrderRepo struct {
 	Db *sql.DB
 }
@@ -20,16 +37,100 @@ func NewOrderRepo(db *sql.DB) *OrderRepo {
 	return &OrderRepo{Db: db}
 }
 
-func (o *OrderRepo) CreateOrder(ctx context.Context, order *pb.ReqCreateOrder, total float64) (*pb.OrderInfo, error) {
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func (o *OrderRepo) CreateOrder(ctx context.Context, order *pb.ReqCreateOrder) (*pb.OrderInfo, error) {
+	var res *pb.OrderInfo
+	err := withTx(ctx, o.Db, func(tx *sql.Tx) (err error) {
+		res, err = insertOrder(ctx, tx, order, lifecycle.Pending, nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CreateOrderInSlot stores an order for the delivery time of the request in
+// the given status. The slot of the kitchen is locked while its orders are
+// counted, so concurrent orders cannot overbook it.
+func (o *OrderRepo) CreateOrderInSlot(ctx context.Context, order *pb.ReqCreateOrder, orderStatus string, slot scheduling.Slot, capacity int) (*pb.OrderInfo, error) {
+	lock := `
+	select pg_advisory_xact_lock(hashtext($1))
+	`
+	count := `
+	select
+		count(*)
+	from
+		orders
+	where
+		kitchen_id = $1 and delivery_time >= $2 and delivery_time < $3 and
+			status <> all($4) and deleted_at is null
+	`
+
+	var res *pb.OrderInfo
+	err := withTx(ctx, o.Db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, lock, "order_slot:"+order.KitchenId+":"+slot.Start.UTC().Format(time.RFC3339))
+		if err != nil {
+			return err
+		}
+
+		booked := 0
+		closed := []string{lifecycle.Cancelled, lifecycle.Rejected}
+		err = tx.QueryRowContext(ctx, count, order.KitchenId, slot.Start, slot.End, pq.Array(closed)).Scan(&booked)
+		if err != nil {
+			return err
+		}
+		if booked >= capacity {
+			return ErrSlotFull
+		}
+
+		res, err = insertOrder(ctx, tx, order, orderStatus, order.DeliveryTime)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// insertOrder prices the items of an order, stores it with the first entry of
+// its status history and its OrderCreated event. The dishes are locked for
+// share until tx ends, so their prices and availability cannot change before
+// the order is committed. A nil deliveryTime leaves the delivery time to the
+// route.
+func insertOrder(ctx context.Context, tx *sql.Tx, order *pb.ReqCreateOrder, orderStatus string, deliveryTime any) (*pb.OrderInfo, error) {
 	query := `
-	INSERT INTO orders (
-		id, user_id, kitchen_id, items, total_amount, status, delivery_address,
-		delivery_time, created_at, updated_at
-	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	with created as (
+		INSERT INTO orders (
+			id, user_id, kitchen_id, items, total_amount, currency, status, delivery_address,
+			delivery_time, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		returning id, user_id, status, created_at
+	)
+	insert into
+		order_status_history(id, order_id, to_status, actor_id, created_at)
+	select
+		$12, id, status, user_id, created_at
+	from
+		created
 	`
 
+	dishes, err := selectDishes(ctx, tx, pricing.DishIds(order.Items), "for share")
+	if err != nil {
+		return nil, err
+	}
+	items, total, err := pricing.Price(order.KitchenId, order.Items, dishes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidItems, err)
+	}
+
 	now := time.Now()
-	deliveryTime := now.Add(time.Minute * 15).Format(time.RFC3339)
 	createdAt := now.Format(time.RFC3339)
 	updatedAt := now.Format(time.RFC3339)
 
@@ -37,11 +138,11 @@ func (o *OrderRepo) CreateOrder(ctx context.Context, order *pb.ReqCreateOrder, t
 		Id:              uuid.NewString(),
 		UserId:          order.UserId,
 		KitchenId:       order.KitchenId,
-		Items:           order.Items,
-		TotalAmount:     total,
-		Status:          "preparing",
+		Items:           items,
+		TotalAmount:     total.Float(),
+		TotalMoney:      &pb.Money{Amount: total.Amount, Currency: total.Currency},
+		Status:          orderStatus,
 		DeliveryAddress: order.DeliveryAddress,
-		DeliveryTime:    deliveryTime,
 		CreatedAt:       createdAt,
 		UpdatedAt:       updatedAt,
 	}
@@ -51,25 +152,59 @@ func (o *OrderRepo) CreateOrder(ctx context.Context, order *pb.ReqCreateOrder, t
 		return nil, err
 	}
 
-	_, err = o.Db.ExecContext(ctx, query, res.Id, res.UserId, res.KitchenId, string(data), total, res.Status,
-		res.DeliveryAddress, res.DeliveryTime, res.CreatedAt, res.UpdatedAt)
+	_, err = tx.ExecContext(ctx, query, res.Id, res.UserId, res.KitchenId, string(data), total.String(), total.Currency, res.Status,
+		res.DeliveryAddress, deliveryTime, res.CreatedAt, res.UpdatedAt, uuid.NewString())
 
 	if err != nil {
 		return nil, err
 	}
+	if deliveryTime != nil {
+		res.DeliveryTime = order.DeliveryTime
+	}
+
+	err = insertEvent(ctx, tx, models.AggregateOrder, res.Id, models.EventOrderCreated, models.OrderCreatedEvent{
+		OrderId:      res.Id,
+		UserId:       res.UserId,
+		KitchenId:    res.KitchenId,
+		Status:       res.Status,
+		TotalAmount:  total.Amount,
+		Currency:     total.Currency,
+		DeliveryTime: res.DeliveryTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.StatusHistory = []*pb.StatusHistory{{
+		ToStatus:  res.Status,
+		ActorId:   res.UserId,
+		CreatedAt: res.CreatedAt,
+	}}
 
 	return res, nil
 }
 
-func (o *OrderRepo) UpdateOrderStatus(ctx context.Context, status *pb.Status) (*pb.StatusRes, error) {
+// UpdateOrderStatus moves the order from the given status to status.Status and
+// records the transition by actorId, empty for the system. It returns
+// sql.ErrNoRows when the order is no longer in the from status, so concurrent
+// transitions cannot both succeed.
+func (o *OrderRepo) UpdateOrderStatus(ctx context.Context, status *pb.Status, from, actorId string) (*pb.StatusRes, error) {
 	query := `
-	update
-		orders
-	set
-		status = $1,
-		updated_at = $2
-	where
-		id = $3
+	with updated as (
+		update
+			orders
+		set
+			status = $1,
+			updated_at = $2
+		where
+			id = $3 and status = $4 and deleted_at is null
+		returning id
+	)
+	insert into
+		order_status_history(id, order_id, from_status, to_status, actor_id, created_at)
+	select
+		$5, id, $4, $1, nullif($6, '')::uuid, $2
+	from
+		updated
 	`
 
 	res := &pb.StatusRes{
@@ -78,118 +213,436 @@ func (o *OrderRepo) UpdateOrderStatus(ctx context.Context, status *pb.Status) (*
 		UpdatedAt: time.Now().Format(time.RFC3339),
 	}
 
-	_, err := o.Db.ExecContext(ctx, query, res.Status, res.UpdatedAt, res.Id)
+	err := withTx(ctx, o.Db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query, res.Status, res.UpdatedAt, res.Id, from, uuid.NewString(), actorId)
+		if err != nil {
+			return err
+		}
+		if err := requireAffected(result); err != nil {
+			return err
+		}
+
+		return insertEvent(ctx, tx, models.AggregateOrder, res.Id, models.EventOrderStatusChanged, models.OrderStatusChangedEvent{
+			OrderId:    res.Id,
+			FromStatus: from,
+			ToStatus:   res.Status,
+			ActorId:    actorId,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return res, err
+	return res, nil
 }
 
-func (o *OrderRepo) GetOrderById(ctx context.Context, id string) (*pb.OrderInfo, error) {
+// CancelOrder moves an order from the from status to cancelled, storing the
+// reason and the fee kept, and records the transition. Like
+// UpdateOrderStatus it returns sql.ErrNoRows when the order is no longer in
+// the from status.
+func (o *OrderRepo) CancelOrder(ctx context.Context, req *pb.ReqCancelOrder, from, actorId string, fee money.Money) (*pb.StatusRes, error) {
 	query := `
+	with updated as (
+		update
+			orders
+		set
+			status = $1,
+			cancel_reason = $2,
+			cancel_comment = nullif($3, ''),
+			cancellation_fee = $4,
+			updated_at = $5
+		where
+			id = $6 and status = $7 and deleted_at is null
+		returning id
+	)
+	insert into
+		order_status_history(id, order_id, from_status, to_status, actor_id, created_at)
 	select
-		id, user_id, kitchen_id, items, total_amount, status, delivery_address, delivery_time, created_at, updated_at
+		$8, id, $7, $1, nullif($9, '')::uuid, $5
 	from
-		orders
-	where
-		id = $1
+		updated
 	`
 
-	items := ""
-	order := pb.OrderInfo{}
-	row := o.Db.QueryRowContext(ctx, query, id)
-	err := row.Scan(&order.Id, &order.UserId, &order.KitchenId, &items, &order.TotalAmount, &order.Status,
-		&order.DeliveryAddress, &order.DeliveryTime, &order.CreatedAt, &order.UpdatedAt)
+	res := &pb.StatusRes{
+		Id:        req.Id,
+		Status:    lifecycle.Cancelled,
+		UpdatedAt: time.Now().Format(time.RFC3339),
+	}
+
+	err := withTx(ctx, o.Db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query, res.Status, req.Reason, req.Comment, fee.String(), res.UpdatedAt, res.Id,
+			from, uuid.NewString(), actorId)
+		if err != nil {
+			return err
+		}
+		if err := requireAffected(result); err != nil {
+			return err
+		}
+
+		return insertEvent(ctx, tx, models.AggregateOrder, res.Id, models.EventOrderStatusChanged, models.OrderStatusChangedEvent{
+			OrderId:    res.Id,
+			FromStatus: from,
+			ToStatus:   res.Status,
+			ActorId:    actorId,
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	itemsObj := []*pb.Item{}
-	err = json.Unmarshal([]byte(items), &itemsObj)
+	return res, nil
+}
+
+// GetDueScheduledOrders returns up to limit scheduled orders to be delivered
+// before the given time, earliest first.
+func (o *OrderRepo) GetDueScheduledOrders(ctx context.Context, before time.Time, limit int) ([]models.ScheduledOrder, error) {
+	query := `
+	select
+		o.id,
+		o.kitchen_id,
+		o.user_id,
+		exists (
+			select 1 from payments p where p.order_id = o.id and p.status = any($3)
+		)
+	from
+		orders o
+	where
+		o.status = $1 and o.delivery_time <= $2 and o.deleted_at is null
+	order by
+		o.delivery_time
+	limit $4
+	`
+
+	captured := []string{models.PaymentCaptured, models.PaymentPartiallyRefunded}
+	rows, err := o.Db.QueryContext(ctx, query, lifecycle.Scheduled, before, pq.Array(captured), limit)
 	if err != nil {
 		return nil, err
 	}
-	order.Items = itemsObj
+	defer rows.Close()
 
-	return &order, nil
+	orders := []models.ScheduledOrder{}
+	for rows.Next() {
+		order := models.ScheduledOrder{}
+		err = rows.Scan(&order.Id, &order.KitchenId, &order.UserId, &order.Paid)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, rows.Err()
 }
 
-func (o *OrderRepo) GetOrdersForUser(ctx context.Context, filter *pb.Filter) (*pb.Orders, error) {
+// GetEtaOrder loads what the delivery estimate of an order is based on and
+// when the order reached its current status. The queue holds the orders of the
+// same kitchen placed earlier that are not yet ready; each counts with the
+// longest prep time of its dishes.
+func (o *OrderRepo) GetEtaOrder(ctx context.Context, id string) (eta.Order, time.Time, error) {
 	query := `
+	with target as (
+		select id, kitchen_id, status, created_at from orders where id = $1 and deleted_at is null
+	), prep as (
+		select
+			o.id,
+			max(d.prep_time_minutes) as minutes
+		from
+			orders o
+		cross join lateral
+			jsonb_array_elements(o.items) i
+		join
+			dishes d on d.id = (i->>'dish_id')::uuid
+		where
+			o.kitchen_id = (select kitchen_id from target) and o.deleted_at is null and
+				(o.id = $1 or (o.status = any($2) and (o.created_at, o.id) < (select created_at, id from target)))
+		group by
+			o.id
+	)
 	select
-		id,
-		user_id,
-		status,
-		total_amount,
-		delivery_time
+		t.status,
+		coalesce((select minutes from prep where id = $1), 0),
+		coalesce((select sum(minutes) from prep where id <> $1), 0),
+		coalesce((select duration from delivery_routes r where r.order_id = $1 and r.deleted_at is null), 0),
+		coalesce((select max(h.created_at) from order_status_history h where h.order_id = $1), t.created_at)
 	from
+		target t
+	`
+
+	queued := []string{lifecycle.Pending, lifecycle.Paid, lifecycle.Accepted, lifecycle.Preparing}
+	order := eta.Order{}
+	var prep, queue, route int64
+	var since time.Time
+	err := o.Db.QueryRowContext(ctx, query, id, pq.Array(queued)).Scan(&order.Status, &prep, &queue, &route, &since)
+	if err != nil {
+		return eta.Order{}, time.Time{}, err
+	}
+	order.PrepTime = time.Duration(prep) * time.Minute
+	order.QueuePrepTime = time.Duration(queue) * time.Minute
+	order.Route = time.Duration(route) * time.Second
+
+	return order, since, nil
+}
+
+// SetEstimatedDeliveryTime stores the delivery estimate of an order. A nil
+// estimate clears it.
+func (o *OrderRepo) SetEstimatedDeliveryTime(ctx context.Context, id string, estimate *time.Time) error {
+	query := `
+	update
 		orders
+	set
+		estimated_delivery_time = $1
+	where
+		id = $2
+	`
+
+	_, err := o.Db.ExecContext(ctx, query, estimate, id)
+
+	return err
+}
+
+func (o *OrderRepo) GetStatusHistory(ctx context.Context, orderId string) ([]*pb.StatusHistory, error) {
+	query := `
+	select
+		from_status,
+		to_status,
+		actor_id,
+		created_at
+	from
+		order_status_history
 	where
-		user_id = $1 
+		order_id = $1
+	order by
+		created_at
 	`
-	query += fmt.Sprintf(" offset %d", (filter.Page-1)*filter.Limit)
-	query += fmt.Sprintf(" limit %d", filter.Limit)
 
-	rows, err := o.Db.QueryContext(ctx, query, filter.Id)
+	rows, err := o.Db.QueryContext(ctx, query, orderId)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	orders := pb.Orders{}
-
+	history := []*pb.StatusHistory{}
 	for rows.Next() {
-		var order pb.OrderShortInfo
+		var from, actor sql.NullString
+		h := &pb.StatusHistory{}
 
-		err := rows.Scan(&order.Id, &order.UserId, &order.Status, &order.TotalAmount, &order.DeliveryTime)
+		err := rows.Scan(&from, &h.ToStatus, &actor, &h.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
-		orders.Orders = append(orders.Orders, &order)
+		h.FromStatus = from.String
+		h.ActorId = actor.String
+		history = append(history, h)
+	}
+
+	return history, rows.Err()
+}
+
+func (o *OrderRepo) GetOrderById(ctx context.Context, id string) (*pb.OrderInfo, error) {
+	query := `
+	select
+		id, user_id, kitchen_id, items, total_amount, currency, status, delivery_address, delivery_time, created_at,
+		updated_at, estimated_delivery_time
+	from
+		orders
+	where
+		id = $1
+	`
+
+	items := ""
+	var amount, currency string
+	var deliveryTime, estimate sql.NullString
+	order := pb.OrderInfo{}
+	row := o.Db.QueryRowContext(ctx, query, id)
+	err := row.Scan(&order.Id, &order.UserId, &order.KitchenId, &items, &amount, &currency, &order.Status,
+		&order.DeliveryAddress, &deliveryTime, &order.CreatedAt, &order.UpdatedAt, &estimate)
+	if err != nil {
+		return nil, err
+	}
+	order.DeliveryTime = deliveryTime.String
+	order.EstimatedDeliveryTime = estimate.String
+	order.TotalAmount, order.TotalMoney, err = orderAmount(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	itemsObj := []*pb.Item{}
+	err = json.Unmarshal([]byte(items), &itemsObj)
+	if err != nil {
+		return nil, err
+	}
+	order.Items = itemsObj
+
+	return &order, nil
+}
+
+// orderCursor holds the sort keys of the last order of a page.
+type orderCursor struct {
+	pageCursor
+	Total string `json:"total,omitempty"`
+}
+
+// orderSorts maps every order sort to its keyset.
+var orderSorts = map[string]keyset[orderCursor]{
+	models.OrderSortNewest: {
+		orderBy: "created_at desc, id desc",
+		after:   "(created_at, id) < ($%[1]d, $%[2]d)",
+		keys:    func(c *orderCursor) []any { return []any{c.CreatedAt, c.Id} },
+	},
+	models.OrderSortOldest: {
+		orderBy: "created_at asc, id asc",
+		after:   "(created_at, id) > ($%[1]d, $%[2]d)",
+		keys:    func(c *orderCursor) []any { return []any{c.CreatedAt, c.Id} },
+	},
+	models.OrderSortHighest: {
+		orderBy: "total_amount desc, created_at desc, id desc",
+		after:   "(total_amount, created_at, id) < ($%[1]d, $%[2]d, $%[3]d)",
+		keys:    func(c *orderCursor) []any { return []any{c.Total, c.CreatedAt, c.Id} },
+	},
+	models.OrderSortLowest: {
+		orderBy: "total_amount asc, created_at desc, id desc",
+		after:   "(total_amount > $%[1]d or (total_amount = $%[1]d and (created_at, id) < ($%[2]d, $%[3]d)))",
+		keys:    func(c *orderCursor) []any { return []any{c.Total, c.CreatedAt, c.Id} },
+	},
+}
+
+// orderConditions returns the where clause shared by an order listing and its
+// count: the live orders whose column equals filter.Id, narrowed by the
+// optional filters.
+func orderConditions(column string, filter *pb.Filter) (string, []any) {
+	args := []any{filter.Id}
+	conditions := []string{column + " = $1", "deleted_at is null"}
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
 	}
 
-	orders.Total = int64(o.GetOrderCount(ctx))
-	orders.Limit = filter.Limit
-	orders.Page = filter.Page
+	if filter.Status != "" {
+		add("status = $%d", filter.Status)
+	}
+	if filter.StartDate != "" {
+		add("created_at >= $%d", filter.StartDate)
+	}
+	if filter.EndDate != "" {
+		add(untilEndDate("created_at", filter.EndDate), filter.EndDate)
+	}
+	if filter.MinAmount != nil {
+		add("total_amount >= $%d", money.FromProto(filter.MinAmount).String())
+	}
+	if filter.MaxAmount != nil {
+		add("total_amount <= $%d", money.FromProto(filter.MaxAmount).String())
+	}
+	if filter.KitchenId != "" {
+		add("kitchen_id = $%d", filter.KitchenId)
+	}
+	if filter.UserId != "" {
+		add("user_id = $%d", filter.UserId)
+	}
 
-	return &orders, rows.Err()
+	return strings.Join(conditions, " and "), args
 }
 
+// untilEndDate returns the condition that column is not after endDate, with
+// a %d verb for the parameter number. A date-only end date includes the whole
+// day.
+func untilEndDate(column, endDate string) string {
+	if _, err := time.Parse(time.DateOnly, endDate); err == nil {
+		return column + " < $%d::date + 1"
+	}
+	return column + " <= $%d"
+}
+
+// GetOrdersForUser pages through the live orders of a user.
+func (o *OrderRepo) GetOrdersForUser(ctx context.Context, filter *pb.Filter) (*pb.Orders, error) {
+	return o.getOrders(ctx, "user_id", filter)
+}
+
+// GetOrdersForChef pages through the live orders of a kitchen.
 func (o *OrderRepo) GetOrdersForChef(ctx context.Context, filter *pb.Filter) (*pb.Orders, error) {
+	return o.getOrders(ctx, "kitchen_id", filter)
+}
+
+// getOrders lists the orders matching the filter. Total counts every matching
+// order, not only the ones on the page.
+func (o *OrderRepo) getOrders(ctx context.Context, column string, filter *pb.Filter) (*pb.Orders, error) {
+	where, args := orderConditions(column, filter)
+
+	orders := pb.Orders{Page: filter.Page, Limit: filter.Limit}
+	err := o.Db.QueryRowContext(ctx, "select count(*) from orders where "+where, args...).Scan(&orders.Total)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `
 	select
 		id,
 		user_id,
+		kitchen_id,
 		status,
 		total_amount,
-		delivery_time
+		currency,
+		delivery_time,
+		created_at,
+		estimated_delivery_time
 	from
 		orders
 	where
-		kitchen_id = $1 
 	`
-	query += fmt.Sprintf(" offset %d", (filter.Page-1)*filter.Limit)
-	query += fmt.Sprintf(" limit %d", filter.Limit)
+	query += where
+
+	sort := filter.Sort
+	if _, ok := orderSorts[sort]; !ok {
+		sort = models.OrderSortNewest
+	}
+	var after *orderCursor
+	if filter.Cursor != "" {
+		after = &orderCursor{}
+		if err := decodeCursor(filter.Cursor, sort, after); err != nil {
+			return nil, err
+		}
+	}
+	query, args = orderSorts[sort].page(query, args, after, filter.Page, filter.Limit)
 
-	rows, err := o.Db.QueryContext(ctx, query, filter.Id)
+	rows, err := o.Db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
-
-	orders := pb.Orders{}
+	defer rows.Close()
 
 	for rows.Next() {
 		var order pb.OrderShortInfo
+		var amount, currency string
+		var deliveryTime, estimate sql.NullString
 
-		err := rows.Scan(&order.Id, &order.UserId, &order.Status, &order.TotalAmount, &order.DeliveryTime)
+		err := rows.Scan(&order.Id, &order.UserId, &order.KitchenId, &order.Status, &amount, &currency, &deliveryTime,
+			&order.CreatedAt, &estimate)
+		if err != nil {
+			return nil, err
+		}
+		order.DeliveryTime = deliveryTime.String
+		order.EstimatedDeliveryTime = estimate.String
+		order.TotalAmount, order.TotalMoney, err = orderAmount(amount, currency)
 		if err != nil {
 			return nil, err
 		}
 		orders.Orders = append(orders.Orders, &order)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
-	orders.Total = int64(o.GetOrderCount(ctx))
-	orders.Limit = filter.Limit
-	orders.Page = filter.Page
+	if len(orders.Orders) > int(filter.Limit) {
+		orders.Orders = orders.Orders[:filter.Limit]
+		last := orders.Orders[len(orders.Orders)-1]
+		orders.NextCursor, err = cursor.Encode(orderCursor{
+			pageCursor: pageCursor{Sort: sort, CreatedAt: last.CreatedAt, Id: last.Id},
+			Total:      money.FromProto(last.TotalMoney).String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	return &orders, rows.Err()
+	return &orders, nil
 }
 
 func (o *OrderRepo) DeleteOrder(ctx context.Context, id string) error {
@@ -229,49 +682,45 @@ func (o *OrderRepo) ValidateOrderId(ctx context.Context, id string) error {
 	return nil
 }
 
-func (o *OrderRepo) GetOrderCount(ctx context.Context) int {
-	query := `
-	select
-		count(*)
-	where
-		deleted_at is null
-	`
-	count := 0
-	err := o.Db.QueryRowContext(ctx, query).Scan(&count)
-	if err != nil {
-		return 0
-	}
-
-	return count
-}
-
 func (o *OrderRepo) GetKitchenStatistics(ctx context.Context, filter *pb.DateFilter) (*pb.KitchenStatistics, error) {
 	query := `
 	with dish_data as (
 		select
-			jsonb_array_elements(items) as dish
+			jsonb_array_elements(items) as dish,
+			currency
 		from
 			orders
 		where
 			deleted_at is null and kitchen_id = $1 and
-			created_at between $2 and $3
+			created_at >= $2 and ` + fmt.Sprintf(untilEndDate("created_at", filter.EndDate), 3) + `
 	),
 	dish_stats as (
 		select
 			dish ->> 'dish_id' as id,
-			count(*) as orders_count
+			coalesce(max(dish ->> 'name'), max(d.name), '') as name,
+			count(*) as orders_count,
+			coalesce(sum(coalesce(
+				(dish -> 'line_total_money' ->> 'amount')::bigint,
+				round(d.price * 100 * coalesce((dish ->> 'quantity')::int, 1))::bigint
+			)), 0) as revenue,
+			max(dish_data.currency) as currency
 		from
 			dish_data
+		left join
+			dishes d on d.id::text = dish ->> 'dish_id'
 		group by
 			dish ->> 'dish_id'
-		order by
-			orders_count desc
 	)
 	select
 		id,
-		orders_count
+		name,
+		orders_count,
+		revenue,
+		currency
 	from
 		dish_stats
+	order by
+		orders_count desc
 	`
 
 	kitchenStats := pb.KitchenStatistics{}
@@ -285,10 +734,13 @@ func (o *OrderRepo) GetKitchenStatistics(ctx context.Context, filter *pb.DateFil
 	dishStats := []*pb.DishStats{}
 	for rows.Next() {
 		dishStat := &pb.DishStats{}
-		err := rows.Scan(&dishStat.Id, &dishStat.OrdersCount)
+		revenue := money.Money{}
+		err := rows.Scan(&dishStat.Id, &dishStat.Name, &dishStat.OrdersCount, &revenue.Amount, &revenue.Currency)
 		if err != nil {
 			return nil, err
 		}
+		dishStat.Revenue = float32(revenue.Float())
+		dishStat.RevenueMoney = &pb.Money{Amount: revenue.Amount, Currency: revenue.Currency}
 		dishStats = append(dishStats, dishStat)
 	}
 
@@ -298,50 +750,134 @@ func (o *OrderRepo) GetKitchenStatistics(ctx context.Context, filter *pb.DateFil
 }
 
 
-func (o *OrderRepo) GetRevenueStatsForKitchen(ctx context.Context, filter *pb.DateFilter) (*models.RevenueStats, error){
+// GetOrdersByHour buckets the orders of a kitchen by day of the week and hour
+// of the day in the given time zone, the one all kitchens share. Cancelled and
+// rejected orders are left out.
+func (o *OrderRepo) GetOrdersByHour(ctx context.Context, filter *pb.DateFilter, timeZone string) ([]models.HourBucket, error) {
 	query := `
 	select
+		extract(dow from created_at at time zone $4)::int,
+		extract(hour from created_at at time zone $4)::int,
 		count(*),
-		sum(total_amount)
+		coalesce(sum(total_amount), 0),
+		max(currency)
 	from
 		orders
 	where
-		kitchen_id = $1 and
-			created_at between $2 and $3
+		deleted_at is null and kitchen_id = $1 and status not in ($5, $6) and
+			created_at >= $2 and ` + fmt.Sprintf(untilEndDate("created_at", filter.EndDate), 3) + `
+	group by
+		1, 2
 	`
+
+	rows, err := o.Db.QueryContext(ctx, query, filter.Id, filter.StartDate, filter.EndDate, timeZone,
+		lifecycle.Cancelled, lifecycle.Rejected)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := []models.HourBucket{}
+	for rows.Next() {
+		bucket := models.HourBucket{}
+		var weekday int
+		var amount, currency string
+		err := rows.Scan(&weekday, &bucket.Hour, &bucket.Orders, &amount, &currency)
+		if err != nil {
+			return nil, err
+		}
+		bucket.Weekday = time.Weekday(weekday)
+		bucket.Revenue, err = money.Parse(amount, currency)
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, rows.Err()
+}
+
+// GetRevenueStatsForKitchen returns the count of live orders of the kitchen
+// that were not cancelled or rejected and the money captured for them net of
+// succeeded refunds.
+func (o *OrderRepo) GetRevenueStatsForKitchen(ctx context.Context, filter *pb.DateFilter) (*models.RevenueStats, error){
+	query := `
+	select
+		count(*),
+		coalesce(sum(c.captured), 0) - coalesce(sum(r.refunded), 0),
+		coalesce(max(o.currency), '')
+	from
+		orders o
+	left join lateral (
+		select
+			sum(p.amount) as captured
+		from
+			payments p
+		where
+			p.order_id = o.id and p.status = any($5)
+	) c on true
+	left join lateral (
+		select
+			sum(rf.amount) as refunded
+		from
+			refunds rf
+		join
+			payments p on p.id = rf.payment_id
+		where
+			p.order_id = o.id and rf.status = $4
+	) r on true
+	where
+		o.kitchen_id = $1 and o.deleted_at is null and o.status <> all($6) and
+			o.created_at >= $2 and ` + fmt.Sprintf(untilEndDate("o.created_at", filter.EndDate), 3)
+
+	captured := []string{models.PaymentCaptured, models.PaymentPartiallyRefunded, models.PaymentRefunded}
+	excluded := []string{lifecycle.Cancelled, lifecycle.Rejected}
 	stats := models.RevenueStats{}
-	row := o.Db.QueryRowContext(ctx, query, filter.Id, filter.StartDate, filter.EndDate)
+	row := o.Db.QueryRowContext(ctx, query, filter.Id, filter.StartDate, filter.EndDate, models.RefundSucceeded,
+		pq.Array(captured), pq.Array(excluded))
 
-	err := row.Scan(&stats.TotalOrders, &stats.Revenue)
+	var amount, currency string
+	err := row.Scan(&stats.TotalOrders, &amount, &currency)
+	if err != nil {
+		return nil, err
+	}
+	stats.Revenue, err = money.Parse(amount, currency)
 
 	return &stats, err
 }
 
+// GetUserStatistics returns the kitchens a user ordered from most within the
+// range. Cancelled and rejected orders are left out.
 func (o *OrderRepo) GetUserStatistics(ctx context.Context, filter *pb.DateFilter) (*pb.UserStatistics, error) {
 	query := `
 	with kitchen_data as (
 		select
 			kitchen_id,
-			total_amount
+			total_amount,
+			currency
 		from
 			orders
 		where
-			deleted_at is null and user_id = $1 and
-			created_at between $2 and $3
+			deleted_at is null and user_id = $1 and status not in ($4, $5) and
+				created_at >= $2 and ` + fmt.Sprintf(untilEndDate("created_at", filter.EndDate), 3) + `
 	)
 	select
 		kitchen_id,
 		count(*),
-		sum(total_amount)
+		sum(total_amount),
+		max(currency)
 	from
 		kitchen_data
 	group by
 		kitchen_id
+	order by
+		count(*) desc, sum(total_amount) desc
 	`
 
 	userStats := pb.UserStatistics{}
 
-	rows, err := o.Db.QueryContext(ctx, query, filter.Id, filter.StartDate, filter.EndDate)
+	rows, err := o.Db.QueryContext(ctx, query, filter.Id, filter.StartDate, filter.EndDate, lifecycle.Cancelled,
+		lifecycle.Rejected)
 	if err != nil {
 		return nil, err
 	}
@@ -350,7 +886,12 @@ func (o *OrderRepo) GetUserStatistics(ctx context.Context, filter *pb.DateFilter
 	kitchenStats := []*pb.KitchenStats{}
 	for rows.Next() {
 		kitchenStat := &pb.KitchenStats{}
-		err := rows.Scan(&kitchenStat.Id, &kitchenStat.OrdersCount, &kitchenStat.TotalSpent)
+		var amount, currency string
+		err := rows.Scan(&kitchenStat.Id, &kitchenStat.OrdersCount, &amount, &currency)
+		if err != nil {
+			return nil, err
+		}
+		kitchenStat.TotalSpent, kitchenStat.TotalSpentMoney, err = orderAmount(amount, currency)
 		if err != nil {
 			return nil, err
 		}
@@ -361,3 +902,14 @@ func (o *OrderRepo) GetUserStatistics(ctx context.Context, filter *pb.DateFilter
 
 	return &userStats, rows.Err()
 }
+
+// orderAmount converts a DECIMAL amount column into the legacy float amount
+// and the exact money amount of the API.
+func orderAmount(amount, currency string) (float64, *pb.Money, error) {
+	total, err := money.Parse(amount, currency)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return total.Float(), &pb.Money{Amount: total.Amount, Currency: total.Currency}, nil
+}
@@ -28,7 +28,7 @@ func TestCreateOrder(t *testing.T) {
 		DeliveryAddress: "hgf",
 		DeliveryTime:    "",
 	}
-	_, err := o.CreateOrder(context.Background(), req, 345)
+	_, err := o.CreateOrder(context.Background(), req)
 	if err != nil {
 		t.Error(err)
 	}
@@ -40,9 +40,19 @@ func TestUpdateOrderStatus(t *testing.T){
 
 	status := pb.Status{
 		Id: "6e5c785c-d427-4ab5-afac-ed00400c08c7",
-		Status: "delivering" ,
+		Status: "accepted" ,
 	}
-	_, err := o.UpdateOrderStatus(context.Background(), &status)
+	_, err := o.UpdateOrderStatus(context.Background(), &status, "pending", "acdb0273-cb22-4168-9caf-360642cff29a")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetStatusHistory(t *testing.T){
+
+	o := newOrderepo()
+
+	_, err := o.GetStatusHistory(context.Background(), "6e5c785c-d427-4ab5-afac-ed00400c08c7")
 	if err != nil {
 		t.Error(err)
 	}
@@ -89,3 +99,22 @@ func TestGetOrdersForChef(t *testing.T){
 	}
 }
 
+
+func TestGetOrdersByHour(t *testing.T) {
+	o := newOrderepo()
+
+	filter := pb.DateFilter{
+		Id:        "0a9b8c7d-6e5f-4a3b-2c1d-0e9f8a7b6c5d",
+		StartDate: "2024-01-01",
+		EndDate:   "2024-12-31",
+	}
+	buckets, err := o.GetOrdersByHour(context.Background(), &filter, "Asia/Tashkent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range buckets {
+		if b.Hour < 0 || b.Hour > 23 {
+			t.Errorf("hour %d out of range", b.Hour)
+		}
+	}
+}
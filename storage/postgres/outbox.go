@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"order_service/pkg/outbox"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// insertEvent adds a domain event to the outbox. Callers pass the transaction
+// of the change the event describes, so both are stored or neither is.
+func insertEvent(ctx context.Context, db execer, aggregateType, aggregateId, eventType string, payload any) error {
+	query := `
+	insert into
+		outbox(
+		id,
+		aggregate_type,
+		aggregate_id,
+		event_type,
+		payload,
+		created_at)
+	values($1, $2, $3, $4, $5, now())
+	`
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, query, uuid.NewString(), aggregateType, aggregateId, eventType, string(data))
+
+	return err
+}
+
+type OutboxRepo struct {
+	Db *sql.DB
+}
+
+func NewOutboxRepo(db *sql.DB) *OutboxRepo {
+	return &OutboxRepo{Db: db}
+}
+
+// Claim leases up to limit due events, oldest first, by moving their next
+// attempt past the lease. Events claimed by other relays are skipped.
+func (o *OutboxRepo) Claim(ctx context.Context, limit int, lease time.Duration) ([]outbox.Pending, error) {
+	query := `
+	with claimed as (
+		select
+			id
+		from
+			outbox
+		where
+			published_at is null and dead_at is null and next_attempt_at <= now()
+		order by
+			created_at, id
+		limit $1
+		for update skip locked
+	)
+	update
+		outbox o
+	set
+		next_attempt_at = now() + make_interval(secs => $2)
+	from
+		claimed
+	where
+		o.id = claimed.id
+	returning
+		o.id,
+		o.aggregate_type,
+		o.aggregate_id,
+		o.event_type,
+		o.payload,
+		o.created_at,
+		o.attempts
+	`
+
+	rows, err := o.Db.QueryContext(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []outbox.Pending{}
+	for rows.Next() {
+		event := outbox.Pending{}
+		payload := ""
+		err = rows.Scan(&event.Id, &event.AggregateType, &event.AggregateId, &event.Type, &payload, &event.OccurredAt,
+			&event.Attempts)
+		if err != nil {
+			return nil, err
+		}
+		event.Payload = []byte(payload)
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// update ... returning does not keep the order of the claim.
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].OccurredAt.Equal(events[j].OccurredAt) {
+			return events[i].OccurredAt.Before(events[j].OccurredAt)
+		}
+		return events[i].Id < events[j].Id
+	})
+
+	return events, nil
+}
+
+func (o *OutboxRepo) MarkPublished(ctx context.Context, id string) error {
+	query := `
+	update
+		outbox
+	set
+		published_at = now(),
+		attempts = attempts + 1,
+		last_error = null
+	where
+		id = $1
+	`
+
+	_, err := o.Db.ExecContext(ctx, query, id)
+
+	return err
+}
+
+// MarkFailed records a failed attempt and when to try again. A dead event is
+// not tried again.
+func (o *OutboxRepo) MarkFailed(ctx context.Context, id string, cause string, retryAt time.Time, dead bool) error {
+	query := `
+	update
+		outbox
+	set
+		attempts = attempts + 1,
+		last_error = $2,
+		next_attempt_at = $3,
+		dead_at = case when $4 then now() end
+	where
+		id = $1
+	`
+
+	_, err := o.Db.ExecContext(ctx, query, id, cause, retryAt, dead)
+
+	return err
+}
@@ -3,13 +3,33 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	pb "order_service/genproto/payment"
+	"order_service/models"
+	"order_service/pkg/money"
+	"order_service/pkg/vault"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+var (
+	// ErrOrderNotPayable is returned when a payment is created for an order
+	// that is no longer waiting for one.
+	ErrOrderNotPayable = errors.New("order cannot be paid")
+	// ErrPaymentExists is returned when a payment is created for an order
+	// that already has one in progress or captured.
+	ErrPaymentExists = errors.New("order already has an active payment")
+)
+
+// activePayments are the payment statuses that block another payment of the
+// same order. Failed and voided payments may be retried.
+var activePayments = []string{models.PaymentPending, models.PaymentAuthorized, models.PaymentCaptured,
+	models.PaymentPartiallyRefunded}
+
 type PaymentRepo struct {
 	Db *sql.DB
 }
@@ -18,35 +38,206 @@ func NewPaymentRepo(db *sql.DB) *PaymentRepo {
 	return &PaymentRepo{Db: db}
 }
 
-func (p *PaymentRepo) CreatePayment(ctx context.Context, req *pb.ReqCreatePayment, amount float64) (*pb.PaymentInfo, error) {
+// CreatePayment stores a pending payment. Only the vault token, brand and last
+// four digits of the card are kept; card is nil for cash payments. The order
+// row is locked while its payments are checked, so concurrent requests cannot
+// both pay it: the loser gets ErrPaymentExists, or ErrOrderNotPayable when the
+// order has left the given payable statuses.
+func (p *PaymentRepo) CreatePayment(ctx context.Context, req *pb.ReqCreatePayment, amount money.Money, card *vault.Token, payable []string) (*pb.PaymentInfo, error) {
+	lock := `
+	select
+		status
+	from
+		orders
+	where
+		id = $1 and deleted_at is null
+	for update
+	`
+	active := `
+	select exists (
+		select 1 from payments where order_id = $1 and status = any($2)
+	)
+	`
 	query := `
 	insert into
 		payments(
 		id,
 		order_id,
-		card_number,
+		card_token,
+		card_brand,
+		card_last4,
 		amount,
+		currency,
 		status,
 		payment_method,
 		transaction_id,
 		created_at,
 		updated_at)
-	values($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	values($1, $2, nullif($3, ''), nullif($4, ''), nullif($5, ''), $6, $7, $8, $9, $10, $11, $12)
 	`
 	currentTime := time.Now().Format(time.RFC3339)
 	res := pb.PaymentInfo{
 		Id:            uuid.NewString(),
 		OrderId:       req.OrderId,
-		Amount:        amount,
-		Status:        "Paid",
+		Amount:        amount.Float(),
+		AmountMoney:   &pb.Money{Amount: amount.Amount, Currency: amount.Currency},
+		Status:        models.PaymentPending,
 		TransactionId: "",
+		PaymentMethod: req.PaymentMethod,
 		CreatedAt:     currentTime,
 		UpdatedAt:     currentTime,
 	}
-	_, err := p.Db.ExecContext(ctx, query, res.Id, res.OrderId, req.CardNumber, amount, res.Status, req.PaymentMethod, res.TransactionId,
-		res.CreatedAt, res.UpdatedAt)
+	cardToken := ""
+	if card != nil {
+		cardToken, res.CardBrand, res.CardLast4 = card.Token, card.Brand, card.Last4
+	}
+
+	err := withTx(ctx, p.Db, func(tx *sql.Tx) error {
+		orderStatus := ""
+		if err := tx.QueryRowContext(ctx, lock, req.OrderId).Scan(&orderStatus); err != nil {
+			return err
+		}
+		if !slices.Contains(payable, orderStatus) {
+			return ErrOrderNotPayable
+		}
+
+		exists := false
+		if err := tx.QueryRowContext(ctx, active, req.OrderId, pq.Array(activePayments)).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return ErrPaymentExists
+		}
+
+		_, err := tx.ExecContext(ctx, query, res.Id, res.OrderId, cardToken, res.CardBrand, res.CardLast4, amount.String(),
+			amount.Currency, res.Status, req.PaymentMethod, res.TransactionId, res.CreatedAt, res.UpdatedAt)
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrPaymentExists
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UpdatePaymentStatus records the outcome of a gateway operation. An empty
+// transactionId keeps the stored one. A capture also stores its
+// PaymentCaptured event.
+func (p *PaymentRepo) UpdatePaymentStatus(ctx context.Context, payment *pb.PaymentInfo) (*pb.PaymentInfo, error) {
+	query := `
+	update
+		payments
+	set
+		status = $1,
+		transaction_id = coalesce(nullif($2, ''), transaction_id),
+		failure_reason = nullif($3, ''),
+		updated_at = $4
+	where
+		id = $5
+	returning
+		coalesce(transaction_id, ''), created_at
+	`
+
+	payment.UpdatedAt = time.Now().Format(time.RFC3339)
+	err := withTx(ctx, p.Db, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, query, payment.Status, payment.TransactionId, payment.FailureReason,
+			payment.UpdatedAt, payment.Id).Scan(&payment.TransactionId, &payment.CreatedAt)
+		if err != nil || payment.Status != models.PaymentCaptured {
+			return err
+		}
+
+		return insertEvent(ctx, tx, models.AggregatePayment, payment.Id, models.EventPaymentCaptured, models.PaymentCapturedEvent{
+			PaymentId:     payment.Id,
+			OrderId:       payment.OrderId,
+			Amount:        payment.GetAmountMoney().GetAmount(),
+			Currency:      payment.GetAmountMoney().GetCurrency(),
+			TransactionId: payment.TransactionId,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// GetPaymentById returns the payment together with the sum of its succeeded
+// refunds.
+func (p *PaymentRepo) GetPaymentById(ctx context.Context, id string) (*pb.PaymentInfo, error) {
+	query := `
+	select
+		p.id,
+		p.order_id,
+		p.amount,
+		p.currency,
+		p.status,
+		coalesce(p.transaction_id, ''),
+		p.payment_method,
+		coalesce(p.card_brand, ''),
+		coalesce(p.card_last4, ''),
+		coalesce(p.failure_reason, ''),
+		p.created_at,
+		p.updated_at,
+		coalesce((
+			select sum(r.amount) from refunds r where r.payment_id = p.id and r.status = $2
+		), 0)
+	from
+		payments p
+	where
+		p.id = $1
+	`
+
+	res := pb.PaymentInfo{}
+	var amount, currency, refunded string
+	err := p.Db.QueryRowContext(ctx, query, id, models.RefundSucceeded).Scan(&res.Id, &res.OrderId, &amount, &currency,
+		&res.Status, &res.TransactionId, &res.PaymentMethod, &res.CardBrand, &res.CardLast4, &res.FailureReason,
+		&res.CreatedAt, &res.UpdatedAt, &refunded)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := money.Parse(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	refundedTotal, err := money.Parse(refunded, currency)
+	if err != nil {
+		return nil, err
+	}
+	res.Amount = total.Float()
+	res.AmountMoney = &pb.Money{Amount: total.Amount, Currency: total.Currency}
+	res.RefundedMoney = &pb.Money{Amount: refundedTotal.Amount, Currency: refundedTotal.Currency}
+
+	return &res, nil
+}
+
+// GetRefundablePaymentByOrderId returns the latest payment of an order that
+// still has captured money, or sql.ErrNoRows when there is none.
+func (p *PaymentRepo) GetRefundablePaymentByOrderId(ctx context.Context, orderId string) (*pb.PaymentInfo, error) {
+	query := `
+	select
+		id
+	from
+		payments
+	where
+		order_id = $1 and status = any($2)
+	order by
+		created_at desc
+	limit 1
+	`
+
+	id := ""
+	statuses := []string{models.PaymentCaptured, models.PaymentPartiallyRefunded}
+	err := p.Db.QueryRowContext(ctx, query, orderId, pq.Array(statuses)).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
 
-	return &res, err
+	return p.GetPaymentById(ctx, id)
 }
 
 func (p *PaymentRepo) ValidateReviewId(ctx context.Context, id string) error {
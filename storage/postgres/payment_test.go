@@ -3,6 +3,8 @@ package postgres
 import (
 	"context"
 	pb "order_service/genproto/payment"
+	"order_service/pkg/money"
+	"order_service/pkg/vault"
 	"testing"
 )
 
@@ -20,14 +22,27 @@ func TestCreatePayment(t *testing.T) {
 
 	req := pb.ReqCreatePayment{
 		OrderId:       "8529dbef-1313-4c78-b990-7a84ecb7d2c3",
-		PaymentMethod: "",
-		CardNumber:    "",
-		ExpiryDate:    "",
-		Cvv:           "",
-	}	
+		PaymentMethod: "credit_card",
+	}
+	card := &vault.Token{Token: "tok_0f1e2d3c", Brand: "visa", Last4: "4242"}
 
-	_, err := p.CreatePayment(context.Background(), &req, 4)
+	_, err := p.CreatePayment(context.Background(), &req, money.New(400, "UZS"), card, []string{"pending", "scheduled"})
 	if err != nil {
 		t.Error(err)
 	}
-}
\ No newline at end of file
+}
+
+func TestUpdatePaymentStatus(t *testing.T) {
+	p := newPaymentRepo()
+
+	payment := pb.PaymentInfo{
+		Id:            "3f4f1c5e-5b1a-4a57-9d0f-7a0c8c5b2d11",
+		Status:        "captured",
+		TransactionId: "fake_3f4f1c5e",
+	}
+
+	_, err := p.UpdatePaymentStatus(context.Background(), &payment)
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	pb "order_service/genproto/payment"
+	"order_service/models"
+	"order_service/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrRefundExceedsCaptured is returned when a refund would take the refunded
+// total of a payment above its captured amount.
+var ErrRefundExceedsCaptured = errors.New("refund exceeds captured amount")
+
+type RefundRepo struct {
+	Db *sql.DB
+}
+
+func NewRefundRepo(db *sql.DB) *RefundRepo {
+	return &RefundRepo{Db: db}
+}
+
+// CreateRefund stores a pending refund of the payment. The payment row is
+// locked while the pending and succeeded refunds are summed, so concurrent
+// refunds can never exceed the captured amount together.
+func (r *RefundRepo) CreateRefund(ctx context.Context, paymentId string, amount money.Money, reason string) (*pb.RefundInfo, error) {
+	payment := `
+	select
+		amount,
+		currency,
+		status
+	from
+		payments
+	where
+		id = $1
+	for update
+	`
+	refunded := `
+	select
+		coalesce(sum(amount), 0)
+	from
+		refunds
+	where
+		payment_id = $1 and status <> $2
+	`
+	insert := `
+	insert into
+		refunds(
+		id,
+		payment_id,
+		amount,
+		currency,
+		status,
+		reason,
+		created_at,
+		updated_at)
+	values($1, $2, $3, $4, $5, nullif($6, ''), $7, $8)
+	`
+
+	var res pb.RefundInfo
+	err := withTx(ctx, r.Db, func(tx *sql.Tx) error {
+		var capturedAmount, currency, status, refundedAmount string
+		err := tx.QueryRowContext(ctx, payment, paymentId).Scan(&capturedAmount, &currency, &status)
+		if err != nil {
+			return err
+		}
+		err = tx.QueryRowContext(ctx, refunded, paymentId, models.RefundFailed).Scan(&refundedAmount)
+		if err != nil {
+			return err
+		}
+
+		captured, err := money.Parse(capturedAmount, currency)
+		if err != nil {
+			return err
+		}
+		switch status {
+		case models.PaymentCaptured, models.PaymentPartiallyRefunded, models.PaymentRefunded:
+		default:
+			captured = money.New(0, currency)
+		}
+		reserved, err := money.Parse(refundedAmount, currency)
+		if err != nil {
+			return err
+		}
+		total, err := reserved.Add(amount)
+		if err != nil {
+			return err
+		}
+		if total.Amount > captured.Amount {
+			return ErrRefundExceedsCaptured
+		}
+
+		currentTime := time.Now().Format(time.RFC3339)
+		res = pb.RefundInfo{
+			Id:        uuid.NewString(),
+			PaymentId: paymentId,
+			Amount:    &pb.Money{Amount: amount.Amount, Currency: currency},
+			Status:    models.RefundPending,
+			Reason:    reason,
+			CreatedAt: currentTime,
+			UpdatedAt: currentTime,
+		}
+		_, err = tx.ExecContext(ctx, insert, res.Id, res.PaymentId, amount.String(), currency, res.Status, res.Reason,
+			res.CreatedAt, res.UpdatedAt)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UpdateRefundStatus records the gateway outcome of a refund. A succeeded
+// refund also moves its payment to refunded or partially refunded.
+func (r *RefundRepo) UpdateRefundStatus(ctx context.Context, refund *pb.RefundInfo) (*pb.RefundInfo, error) {
+	update := `
+	update
+		refunds
+	set
+		status = $1,
+		failure_reason = nullif($2, ''),
+		updated_at = $3
+	where
+		id = $4
+	`
+	payment := `
+	update
+		payments p
+	set
+		status = case
+			when t.refunded >= p.amount then $2
+			else $3
+		end,
+		updated_at = $4
+	from (
+		select
+			coalesce(sum(amount), 0) as refunded
+		from
+			refunds
+		where
+			payment_id = $1 and status = $5
+	) t
+	where
+		p.id = $1
+	`
+
+	refund.UpdatedAt = time.Now().Format(time.RFC3339)
+	err := withTx(ctx, r.Db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, update, refund.Status, refund.FailureReason, refund.UpdatedAt, refund.Id)
+		if err != nil {
+			return err
+		}
+		if err := requireAffected(result); err != nil {
+			return err
+		}
+
+		if refund.Status != models.RefundSucceeded {
+			return nil
+		}
+		_, err = tx.ExecContext(ctx, payment, refund.PaymentId, models.PaymentRefunded, models.PaymentPartiallyRefunded,
+			refund.UpdatedAt, models.RefundSucceeded)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
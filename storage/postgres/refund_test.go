@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"context"
+	"order_service/pkg/money"
+	"testing"
+)
+
+func newRefundRepo() *RefundRepo {
+	db, err := ConnectDB()
+	if err != nil {
+		panic(err)
+	}
+
+	return &RefundRepo{Db: db}
+}
+
+func TestCreateRefund(t *testing.T) {
+	r := newRefundRepo()
+
+	_, err := r.CreateRefund(context.Background(), "3f4f1c5e-5b1a-4a57-9d0f-7a0c8c5b2d11", money.New(100, "UZS"),
+		"wrong dish delivered")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -3,22 +3,40 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	pb "order_service/genproto/review"
 	"order_service/models"
+	"order_service/pkg/cursor"
+	"order_service/pkg/rating"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+// ErrReviewExists is returned when the order already has a review.
+var ErrReviewExists = errors.New("order has already been reviewed")
+
+// globalAverageTTL is how long the average rating of all kitchens is reused
+// before it is computed again.
+const globalAverageTTL = 5 * time.Minute
+
 type ReviewRepo struct {
 	Db *sql.DB
+
+	mu                   sync.Mutex
+	globalAverage        float64
+	globalAverageExpires time.Time
 }
 
 func NewReviewRepo(db *sql.DB) *ReviewRepo {
 	return &ReviewRepo{Db: db}
 }
 
+// CreateReview stores the review of an order. The kitchen must be the one of
+// the order; a second live review of the same order returns ErrReviewExists.
 func (r *ReviewRepo) CreateReview(ctx context.Context, review *pb.ReqCreateReview) (*pb.ReviewInfo, error) {
 	query := `
 	insert into
@@ -46,74 +64,514 @@ func (r *ReviewRepo) CreateReview(ctx context.Context, review *pb.ReqCreateRevie
 		UpdatedAt: currentTime,
 	}
 
-	_, err := r.Db.ExecContext(ctx, query, res.Id, res.OrderId, res.UserId, res.KitchenId, res.Rating, res.Comment,
-		res.CreatedAt, res.UpdatedAt)
+	err := withTx(ctx, r.Db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, query, res.Id, res.OrderId, res.UserId, res.KitchenId, res.Rating, res.Comment,
+			res.CreatedAt, res.UpdatedAt)
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrReviewExists
+		}
+		if err != nil {
+			return err
+		}
 
-	return &res, err
+		return insertEvent(ctx, tx, models.AggregateReview, res.Id, models.EventReviewPosted, models.ReviewPostedEvent{
+			ReviewId:  res.Id,
+			OrderId:   res.OrderId,
+			UserId:    res.UserId,
+			KitchenId: res.KitchenId,
+			Rating:    res.Rating,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
 }
 
-func (r *ReviewRepo) GetReviewsByKitchenId(ctx context.Context, filter *pb.Filter) (*pb.Reviews, error) {
+// reviewCursor holds the sort keys of the last review of a page.
+type reviewCursor struct {
+	pageCursor
+	Rating     int32 `json:"rating,omitempty"`
+	HasComment bool  `json:"has_comment,omitempty"`
+}
+
+// reviewSorts maps every review sort to its keyset.
+var reviewSorts = map[string]keyset[reviewCursor]{
+	models.ReviewSortNewest: {
+		orderBy: "r.created_at desc, r.id desc",
+		after:   "(r.created_at, r.id) < ($%[1]d, $%[2]d)",
+		keys:    func(c *reviewCursor) []any { return []any{c.CreatedAt, c.Id} },
+	},
+	models.ReviewSortHighest: {
+		orderBy: "r.rating desc, r.created_at desc, r.id desc",
+		after:   "(r.rating, r.created_at, r.id) < ($%[1]d, $%[2]d, $%[3]d)",
+		keys:    func(c *reviewCursor) []any { return []any{c.Rating, c.CreatedAt, c.Id} },
+	},
+	models.ReviewSortLowest: {
+		orderBy: "r.rating asc, r.created_at desc, r.id desc",
+		after:   "(r.rating > $%[1]d or (r.rating = $%[1]d and (r.created_at, r.id) < ($%[2]d, $%[3]d)))",
+		keys:    func(c *reviewCursor) []any { return []any{c.Rating, c.CreatedAt, c.Id} },
+	},
+	models.ReviewSortHasComment: {
+		orderBy: "(coalesce(r.comment, '') <> '') desc, r.created_at desc, r.id desc",
+		after:   "((coalesce(r.comment, '') <> ''), r.created_at, r.id) < ($%[1]d, $%[2]d, $%[3]d)",
+		keys:    func(c *reviewCursor) []any { return []any{c.HasComment, c.CreatedAt, c.Id} },
+	},
+}
+
+// GetReviewsByKitchenId pages through the live reviews of a kitchen with their
+// replies. With visibleOnly, flagged and hidden reviews are left out.
+func (r *ReviewRepo) GetReviewsByKitchenId(ctx context.Context, filter *pb.Filter, visibleOnly bool) (*pb.Reviews, error) {
 	query := `
 	select
-		id,
-		order_id,
-		user_id,
-		rating,
-		comment,
-		created_at,
-		updated_at
+		r.id,
+		r.order_id,
+		r.user_id,
+		r.rating::int,
+		coalesce(r.comment, ''),
+		r.created_at,
+		r.updated_at,
+		r.moderation_status,
+		rp.id,
+		rp.chef_id,
+		rp.comment,
+		rp.created_at,
+		rp.updated_at
 	from
-		reviews
+		reviews r
+	left join
+		review_replies rp on rp.review_id = r.id
 	where
-		kitchen_id = $1
+		r.kitchen_id = $1 and r.deleted_at is null and
+			r.moderation_status = any($2)
 	`
 
+	statuses := []string{models.ReviewVisible}
+	if !visibleOnly {
+		statuses = append(statuses, models.ReviewFlagged, models.ReviewHidden)
+	}
+	args := []any{filter.Id, pq.Array(statuses)}
+
+	sort := filter.Sort
+	if _, ok := reviewSorts[sort]; !ok {
+		sort = models.ReviewSortNewest
+	}
+	var after *reviewCursor
+	if filter.Cursor != "" {
+		after = &reviewCursor{}
+		if err := decodeCursor(filter.Cursor, sort, after); err != nil {
+			return nil, err
+		}
+	}
+	query, args = reviewSorts[sort].page(query, args, after, filter.Page, filter.Limit)
+
 	reviews := pb.Reviews{}
 
-	rows, err := r.Db.QueryContext(ctx, query, filter.Id)
+	rows, err := r.Db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var review pb.ReviewShortInfo
+		var replyId, chefId, reply, repliedAt, replyUpdatedAt sql.NullString
 
-		err = rows.Scan(&review.Id, &review.OrderId, &review.UserId, &review.Rating, &review.Comment, &review.CreatedAt, &review.UpdatedAt)
+		err = rows.Scan(&review.Id, &review.OrderId, &review.UserId, &review.Rating, &review.Comment, &review.CreatedAt,
+			&review.UpdatedAt, &review.ModerationStatus, &replyId, &chefId, &reply, &repliedAt, &replyUpdatedAt)
 		if err != nil {
 			return nil, err
 		}
+		if replyId.Valid {
+			review.Reply = &pb.ReviewReply{Id: replyId.String, ReviewId: review.Id, ChefId: chefId.String,
+				Comment: reply.String, CreatedAt: repliedAt.String, UpdatedAt: replyUpdatedAt.String}
+		}
 		reviews.Reviews = append(reviews.Reviews, &review)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(reviews.Reviews) > int(filter.Limit) {
+		reviews.Reviews = reviews.Reviews[:filter.Limit]
+		last := reviews.Reviews[len(reviews.Reviews)-1]
+		reviews.NextCursor, err = cursor.Encode(reviewCursor{
+			pageCursor: pageCursor{Sort: sort, CreatedAt: last.CreatedAt, Id: last.Id},
+			Rating:     last.Rating,
+			HasComment: last.Comment != "",
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The total counts the same reviews as the list; the average leaves
+	// hidden ones out like the other stats.
+	countQuery := `
+	select
+		count(*)
+	from
+		reviews
+	where
+		kitchen_id = $1 and deleted_at is null and moderation_status = any($2)
+	`
+	err = r.Db.QueryRowContext(ctx, countQuery, filter.Id, pq.Array(statuses)).Scan(&reviews.Total)
+	if err != nil {
+		return nil, err
+	}
+
 	stats, err := r.GetStatisticsOfReviews(ctx, filter.Id)
 	if err != nil {
 		return nil, err
 	}
-	reviews.Total = int64(stats.TotalNumberOfComments)
 	reviews.AverageRating = stats.AvarageRating
 	reviews.Page = filter.Page
 	reviews.Limit = filter.Limit
 
-	return &reviews, rows.Err()
+	return &reviews, nil
 }
 
 func (r *ReviewRepo) GetStatisticsOfReviews(ctx context.Context, id string) (*models.ReviewsStats, error) {
 	query := `
 	select 
 		count(*),
-		round(avg(rating)::numeric, 2)
+		coalesce(round(avg(rating)::numeric, 2), 0)
 	from
 		reviews
 	where
-		kitchen_id = $1
+		kitchen_id = $1 and deleted_at is null and
+			moderation_status <> $2
 	`
 
 	res := models.ReviewsStats{}
 
-	err := r.Db.QueryRowContext(ctx, query, id).Scan(&res.TotalNumberOfComments, &res.AvarageRating)
+	err := r.Db.QueryRowContext(ctx, query, id, models.ReviewHidden).Scan(&res.TotalNumberOfComments, &res.AvarageRating)
 
 	return &res, err
 }
 
+// GetRatingStats returns the review count and average of a kitchen together
+// with its star histogram, 30 and 90 day averages and a Bayesian rating
+// weighted against the average of all kitchens.
+func (r *ReviewRepo) GetRatingStats(ctx context.Context, kitchenId string) (*models.ReviewsStats, error) {
+	query := `
+	select
+		count(*),
+		coalesce(round(avg(rating), 2), 0),
+		count(*) filter (where round(rating) = 1),
+		count(*) filter (where round(rating) = 2),
+		count(*) filter (where round(rating) = 3),
+		count(*) filter (where round(rating) = 4),
+		count(*) filter (where round(rating) = 5),
+		coalesce(round(avg(rating) filter (where created_at >= now() - interval '30 days'), 2), 0),
+		coalesce(round(avg(rating) filter (where created_at >= now() - interval '90 days'), 2), 0)
+	from
+		reviews
+	where
+		kitchen_id = $1 and deleted_at is null and moderation_status <> $2
+	`
+
+	res := models.ReviewsStats{}
+	err := r.Db.QueryRowContext(ctx, query, kitchenId, models.ReviewHidden).Scan(&res.TotalNumberOfComments,
+		&res.AvarageRating, &res.Histogram[0], &res.Histogram[1], &res.Histogram[2], &res.Histogram[3],
+		&res.Histogram[4], &res.Average30Days, &res.Average90Days)
+	if err != nil {
+		return nil, err
+	}
+
+	globalAverage, err := r.getGlobalAverage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res.BayesianRating = float32(rating.Bayesian(float64(res.AvarageRating), res.TotalNumberOfComments, globalAverage,
+		rating.PriorWeight))
+
+	return &res, nil
+}
+
+// getGlobalAverage returns the average rating of all kitchens. The scan covers
+// the whole table, so its result is reused for globalAverageTTL.
+func (r *ReviewRepo) getGlobalAverage(ctx context.Context) (float64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Now().Before(r.globalAverageExpires) {
+		return r.globalAverage, nil
+	}
+
+	query := `
+	select
+		coalesce(avg(rating), 0)
+	from
+		reviews
+	where
+		deleted_at is null and moderation_status <> $1
+	`
+
+	var average float64
+	err := r.Db.QueryRowContext(ctx, query, models.ReviewHidden).Scan(&average)
+	if err != nil {
+		return 0, err
+	}
+	r.globalAverage, r.globalAverageExpires = average, time.Now().Add(globalAverageTTL)
+
+	return average, nil
+}
+
+// GetUserAverageRating returns the average rating a user gave in the reviews
+// written within the range.
+func (r *ReviewRepo) GetUserAverageRating(ctx context.Context, userId, startDate, endDate string) (float32, error) {
+	query := `
+	select
+		coalesce(round(avg(rating), 2), 0)
+	from
+		reviews
+	where
+		user_id = $1 and deleted_at is null and
+			created_at >= $2 and ` + fmt.Sprintf(untilEndDate("created_at", endDate), 3)
+
+	var average float32
+	err := r.Db.QueryRowContext(ctx, query, userId, startDate, endDate).Scan(&average)
+
+	return average, err
+}
+
+// GetReviewUserId returns the author of a review that is not deleted.
+func (r *ReviewRepo) GetReviewUserId(ctx context.Context, id string) (string, error) {
+	query := `
+	select
+		user_id
+	from
+		reviews
+	where
+		id = $1 and deleted_at is null
+	`
+
+	userId := ""
+	err := r.Db.QueryRowContext(ctx, query, id).Scan(&userId)
+
+	return userId, err
+}
+
+func (r *ReviewRepo) GetReviewById(ctx context.Context, id string) (*pb.ReviewInfo, error) {
+	query := `
+	select
+		id,
+		order_id,
+		user_id,
+		kitchen_id,
+		rating::int,
+		coalesce(comment, ''),
+		created_at,
+		updated_at,
+		moderation_status
+	from
+		reviews
+	where
+		id = $1 and deleted_at is null
+	`
+
+	res := pb.ReviewInfo{}
+	err := r.Db.QueryRowContext(ctx, query, id).Scan(&res.Id, &res.OrderId, &res.UserId, &res.KitchenId, &res.Rating,
+		&res.Comment, &res.CreatedAt, &res.UpdatedAt, &res.ModerationStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UpdateReview changes the rating and comment of a review and keeps the
+// previous version in review_edits.
+func (r *ReviewRepo) UpdateReview(ctx context.Context, req *pb.ReqUpdateReview) (*pb.ReviewInfo, error) {
+	query := `
+	with previous as (
+		select
+			id,
+			rating,
+			comment
+		from
+			reviews
+		where
+			id = $3 and deleted_at is null
+	), edit as (
+		insert into
+			review_edits(
+			id,
+			review_id,
+			rating,
+			comment,
+			edited_at)
+		select
+			$4, id, rating, comment, now()
+		from
+			previous
+	)
+	update
+		reviews
+	set
+		rating = $1,
+		comment = $2,
+		updated_at = now()
+	where
+		id = $3 and deleted_at is null
+	returning
+		id, order_id, user_id, kitchen_id, rating::int, coalesce(comment, ''), created_at, updated_at,
+		moderation_status
+	`
+
+	res := pb.ReviewInfo{}
+	err := r.Db.QueryRowContext(ctx, query, req.Rating, req.Comment, req.Id, uuid.NewString()).Scan(&res.Id,
+		&res.OrderId, &res.UserId, &res.KitchenId, &res.Rating, &res.Comment, &res.CreatedAt, &res.UpdatedAt,
+		&res.ModerationStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetReviewEdits returns the previous versions of a review, oldest first.
+func (r *ReviewRepo) GetReviewEdits(ctx context.Context, reviewId string) ([]*pb.ReviewEdit, error) {
+	query := `
+	select
+		rating,
+		coalesce(comment, ''),
+		edited_at
+	from
+		review_edits
+	where
+		review_id = $1
+	order by
+		edited_at
+	`
+
+	rows, err := r.Db.QueryContext(ctx, query, reviewId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	edits := []*pb.ReviewEdit{}
+	for rows.Next() {
+		edit := pb.ReviewEdit{}
+		err = rows.Scan(&edit.Rating, &edit.Comment, &edit.EditedAt)
+		if err != nil {
+			return nil, err
+		}
+		edits = append(edits, &edit)
+	}
+
+	return edits, rows.Err()
+}
+
+// ReplyToReview stores the reply of a chef, replacing an earlier reply to the
+// same review.
+func (r *ReviewRepo) ReplyToReview(ctx context.Context, req *pb.ReqReplyToReview, chefId string) (*pb.ReviewReply, error) {
+	query := `
+	insert into
+		review_replies(
+		id,
+		review_id,
+		chef_id,
+		comment,
+		created_at,
+		updated_at)
+	values($1, $2, $3, $4, now(), now())
+	on conflict (review_id) do update set
+		chef_id = excluded.chef_id,
+		comment = excluded.comment,
+		updated_at = now()
+	returning
+		id, created_at, updated_at
+	`
+
+	res := pb.ReviewReply{
+		ReviewId: req.ReviewId,
+		ChefId:   chefId,
+		Comment:  req.Comment,
+	}
+	err := r.Db.QueryRowContext(ctx, query, uuid.NewString(), res.ReviewId, res.ChefId, res.Comment).Scan(&res.Id,
+		&res.CreatedAt, &res.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (r *ReviewRepo) GetReply(ctx context.Context, reviewId string) (*pb.ReviewReply, error) {
+	query := `
+	select
+		id,
+		review_id,
+		chef_id,
+		comment,
+		created_at,
+		updated_at
+	from
+		review_replies
+	where
+		review_id = $1
+	`
+
+	res := pb.ReviewReply{}
+	err := r.Db.QueryRowContext(ctx, query, reviewId).Scan(&res.Id, &res.ReviewId, &res.ChefId, &res.Comment,
+		&res.CreatedAt, &res.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// FlagReview marks a visible review for moderation. Flagged and hidden
+// reviews are left as they are.
+func (r *ReviewRepo) FlagReview(ctx context.Context, req *pb.ReqFlagReview) error {
+	query := `
+	update
+		reviews
+	set
+		moderation_status = $1,
+		moderation_reason = nullif($2, '')
+	where
+		id = $3 and moderation_status = $4 and deleted_at is null
+	`
+
+	_, err := r.Db.ExecContext(ctx, query, models.ReviewFlagged, req.Reason, req.Id, models.ReviewVisible)
+
+	return err
+}
+
+// ModerateReview sets the moderation status of a review. It returns
+// sql.ErrNoRows when the review does not exist.
+func (r *ReviewRepo) ModerateReview(ctx context.Context, req *pb.ReqModerateReview) error {
+	query := `
+	update
+		reviews
+	set
+		moderation_status = $1,
+		moderation_reason = nullif($2, '')
+	where
+		id = $3 and deleted_at is null
+	`
+
+	result, err := r.Db.ExecContext(ctx, query, req.Status, req.Reason, req.Id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
 func (r *ReviewRepo) DeleteReview(ctx context.Context, id string) error {
 	query := `
 	update
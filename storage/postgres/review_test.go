@@ -19,14 +19,88 @@ func TestCreateReview(t *testing.T) {
 	r := newReviewRepo()
 
 	req := pb.ReqCreateReview{
-		OrderId:   "",
-		UserId:    "",
-		KitchenId: "",
-		Rating:    0,
-		Comment:   "",
+		OrderId:   "8529dbef-1313-4c78-b990-7a84ecb7d2c3",
+		UserId:    "6f1c3e2a-9b7d-4c5e-8a1f-2d3b4c5e6f70",
+		KitchenId: "0a9b8c7d-6e5f-4a3b-2c1d-0e9f8a7b6c5d",
+		Rating:    5,
+		Comment:   "Hot and on time",
 	}
 	_, err := r.CreateReview(context.Background(), &req)
 	if err != nil {
 		t.Error(err)
 	}
-}
\ No newline at end of file
+}
+
+func TestUpdateReview(t *testing.T) {
+	r := newReviewRepo()
+
+	req := pb.ReqUpdateReview{
+		Id:      "5d2b7c1e-3a4f-4e6b-9c8d-7e6f5a4b3c2d",
+		Rating:  4,
+		Comment: "Good, but the delivery was late",
+	}
+	_, err := r.UpdateReview(context.Background(), &req)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = r.GetReviewEdits(context.Background(), req.Id)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetReviewsByKitchenId(t *testing.T) {
+	r := newReviewRepo()
+
+	filter := pb.Filter{
+		Id:    "0a9b8c7d-6e5f-4a3b-2c1d-0e9f8a7b6c5d",
+		Limit: 2,
+		Sort:  "highest",
+	}
+	page, err := r.GetReviewsByKitchenId(context.Background(), &filter, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Reviews) > 2 {
+		t.Errorf("expected at most 2 reviews, got %d", len(page.Reviews))
+	}
+
+	if page.NextCursor != "" {
+		filter.Cursor = page.NextCursor
+		_, err = r.GetReviewsByKitchenId(context.Background(), &filter, true)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGetRatingStats(t *testing.T) {
+	r := newReviewRepo()
+
+	stats, err := r.GetRatingStats(context.Background(), "0a9b8c7d-6e5f-4a3b-2c1d-0e9f8a7b6c5d")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var total int64
+	for _, count := range stats.Histogram {
+		total += count
+	}
+	if total != int64(stats.TotalNumberOfComments) {
+		t.Errorf("histogram holds %d reviews, total is %d", total, stats.TotalNumberOfComments)
+	}
+}
+
+func TestGetUserAverageRating(t *testing.T) {
+	r := newReviewRepo()
+
+	average, err := r.GetUserAverageRating(context.Background(), "6f1c3e2a-9b7d-4c5e-8a1f-2d3b4c5e6f70", "2024-01-01",
+		"2024-12-31")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if average != 0 && (average < 1 || average > 5) {
+		t.Errorf("average rating %v out of range", average)
+	}
+}
@@ -0,0 +1,302 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	pb "order_service/genproto/order"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type WorkingHoursRepo struct {
+	Db *sql.DB
+}
+
+func NewWorkingHoursRepo(db *sql.DB) *WorkingHoursRepo {
+	return &WorkingHoursRepo{Db: db}
+}
+
+func (w *WorkingHoursRepo) CreateWorkingHours(ctx context.Context, hours *pb.WorkingHours) (*pb.WorkingHoursRes, error) {
+	query := `
+	insert into
+		working_hours(kitchen_id, day_of_week, open_time, close_time)
+	select
+		$1, day, open, close
+	from
+		unnest($2::integer[], $3::time[], $4::time[]) as t(day, open, close)
+	`
+
+	days, opens, closes := workDays(hours)
+	_, err := w.Db.ExecContext(ctx, query, hours.KitchenId, pq.Array(days), pq.Array(opens), pq.Array(closes))
+	if err != nil {
+		return nil, err
+	}
+
+	return w.GetWorkingHours(ctx, hours.KitchenId)
+}
+
+// UpdateWorkingHours replaces the weekly hours of a kitchen. Days that are no
+// longer work days are removed.
+func (w *WorkingHoursRepo) UpdateWorkingHours(ctx context.Context, hours *pb.WorkingHours) (*pb.WorkingHoursRes, error) {
+	upsert := `
+	insert into
+		working_hours(kitchen_id, day_of_week, open_time, close_time)
+	select
+		$1, day, open, close
+	from
+		unnest($2::integer[], $3::time[], $4::time[]) as t(day, open, close)
+	on conflict (kitchen_id, day_of_week) do update set
+		open_time = excluded.open_time,
+		close_time = excluded.close_time,
+		updated_at = now()
+	`
+	remove := `
+	delete from
+		working_hours
+	where
+		kitchen_id = $1 and not (day_of_week = any($2::integer[]))
+	`
+
+	days, opens, closes := workDays(hours)
+	err := withTx(ctx, w.Db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, upsert, hours.KitchenId, pq.Array(days), pq.Array(opens), pq.Array(closes))
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, remove, hours.KitchenId, pq.Array(days))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return w.GetWorkingHours(ctx, hours.KitchenId)
+}
+
+// GetWorkingHours returns sql.ErrNoRows when the kitchen has no working hours.
+func (w *WorkingHoursRepo) GetWorkingHours(ctx context.Context, kitchenId string) (*pb.WorkingHoursRes, error) {
+	query := `
+	select
+		day_of_week,
+		to_char(open_time, 'HH24:MI'),
+		to_char(close_time, 'HH24:MI'),
+		created_at,
+		updated_at
+	from
+		working_hours
+	where
+		kitchen_id = $1
+	`
+
+	rows, err := w.Db.QueryContext(ctx, query, kitchenId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := map[time.Weekday]*pb.WorkingHoursOfDay{}
+	res := &pb.WorkingHoursRes{KitchenId: kitchenId}
+	for rows.Next() {
+		var day int
+		var createdAt, updatedAt string
+		hours := &pb.WorkingHoursOfDay{IsWorkDay: true}
+
+		err := rows.Scan(&day, &hours.Open, &hours.Close, &createdAt, &updatedAt)
+		if err != nil {
+			return nil, err
+		}
+		days[time.Weekday(day)] = hours
+
+		if res.CreatedAt == "" || createdAt < res.CreatedAt {
+			res.CreatedAt = createdAt
+		}
+		if updatedAt > res.UpdatedAt {
+			res.UpdatedAt = updatedAt
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(days) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	day := func(d time.Weekday) *pb.WorkingHoursOfDay {
+		if hours, ok := days[d]; ok {
+			return hours
+		}
+		return &pb.WorkingHoursOfDay{}
+	}
+	res.Monday = day(time.Monday)
+	res.Tuesday = day(time.Tuesday)
+	res.Wednesday = day(time.Wednesday)
+	res.Thursday = day(time.Thursday)
+	res.Friday = day(time.Friday)
+	res.Saturday = day(time.Saturday)
+	res.Sunday = day(time.Sunday)
+
+	return res, nil
+}
+
+func (w *WorkingHoursRepo) DeleteWorkingHours(ctx context.Context, kitchenId string) error {
+	query := `
+	delete from
+		working_hours
+	where
+		kitchen_id = $1
+	`
+
+	_, err := w.Db.ExecContext(ctx, query, kitchenId)
+
+	return err
+}
+
+func (w *WorkingHoursRepo) CreateOverride(ctx context.Context, override *pb.WorkingHoursOverride) (*pb.WorkingHoursOverride, error) {
+	query := `
+	insert into
+		working_hours_overrides(
+		id,
+		kitchen_id,
+		date,
+		is_closed,
+		open_time,
+		close_time,
+		repeats_yearly,
+		reason,
+		created_at)
+	values($1, $2, $3, $4, nullif($5, '')::time, nullif($6, '')::time, $7, $8, $9)
+	`
+
+	res := &pb.WorkingHoursOverride{
+		Id:            uuid.NewString(),
+		KitchenId:     override.KitchenId,
+		Date:          override.Date,
+		IsClosed:      override.IsClosed,
+		Open:          override.Open,
+		Close:         override.Close,
+		RepeatsYearly: override.RepeatsYearly,
+		Reason:        override.Reason,
+		CreatedAt:     time.Now().Format(time.RFC3339),
+	}
+	if res.IsClosed {
+		res.Open, res.Close = "", ""
+	}
+
+	_, err := w.Db.ExecContext(ctx, query, res.Id, res.KitchenId, res.Date, res.IsClosed, res.Open, res.Close,
+		res.RepeatsYearly, res.Reason, res.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (w *WorkingHoursRepo) GetOverrides(ctx context.Context, kitchenId string) (*pb.WorkingHoursOverrides, error) {
+	query := `
+	select
+		id,
+		kitchen_id,
+		to_char(date, 'YYYY-MM-DD'),
+		is_closed,
+		coalesce(to_char(open_time, 'HH24:MI'), ''),
+		coalesce(to_char(close_time, 'HH24:MI'), ''),
+		repeats_yearly,
+		coalesce(reason, ''),
+		created_at
+	from
+		working_hours_overrides
+	where
+		kitchen_id = $1
+	order by
+		date
+	`
+
+	rows, err := w.Db.QueryContext(ctx, query, kitchenId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := &pb.WorkingHoursOverrides{}
+	for rows.Next() {
+		o := &pb.WorkingHoursOverride{}
+		err := rows.Scan(&o.Id, &o.KitchenId, &o.Date, &o.IsClosed, &o.Open, &o.Close, &o.RepeatsYearly, &o.Reason,
+			&o.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		res.Overrides = append(res.Overrides, o)
+	}
+
+	return res, rows.Err()
+}
+
+func (w *WorkingHoursRepo) GetOverrideById(ctx context.Context, id string) (*pb.WorkingHoursOverride, error) {
+	query := `
+	select
+		id,
+		kitchen_id,
+		to_char(date, 'YYYY-MM-DD'),
+		is_closed,
+		coalesce(to_char(open_time, 'HH24:MI'), ''),
+		coalesce(to_char(close_time, 'HH24:MI'), ''),
+		repeats_yearly,
+		coalesce(reason, ''),
+		created_at
+	from
+		working_hours_overrides
+	where
+		id = $1
+	`
+
+	o := &pb.WorkingHoursOverride{}
+	err := w.Db.QueryRowContext(ctx, query, id).Scan(&o.Id, &o.KitchenId, &o.Date, &o.IsClosed, &o.Open, &o.Close,
+		&o.RepeatsYearly, &o.Reason, &o.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+func (w *WorkingHoursRepo) DeleteOverride(ctx context.Context, id string) error {
+	query := `
+	delete from
+		working_hours_overrides
+	where
+		id = $1
+	`
+
+	_, err := w.Db.ExecContext(ctx, query, id)
+
+	return err
+}
+
+// workDays flattens the work days of the week into parallel arrays of day of
+// week (0 is Sunday, as in Postgres and time.Weekday), open and close times.
+func workDays(hours *pb.WorkingHours) ([]int64, []string, []string) {
+	week := map[time.Weekday]*pb.WorkingHoursOfDay{
+		time.Sunday:    hours.Sunday,
+		time.Monday:    hours.Monday,
+		time.Tuesday:   hours.Tuesday,
+		time.Wednesday: hours.Wednesday,
+		time.Thursday:  hours.Thursday,
+		time.Friday:    hours.Friday,
+		time.Saturday:  hours.Saturday,
+	}
+
+	days, opens, closes := []int64{}, []string{}, []string{}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		hours := week[day]
+		if hours == nil || !hours.IsWorkDay {
+			continue
+		}
+		days = append(days, int64(day))
+		opens = append(opens, hours.Open)
+		closes = append(closes, hours.Close)
+	}
+
+	return days, opens, closes
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	pb "order_service/genproto/order"
+	"testing"
+)
+
+func newWorkingHoursRepo() *WorkingHoursRepo {
+	db, err := ConnectDB()
+	if err != nil {
+		panic(err)
+	}
+
+	return &WorkingHoursRepo{Db: db}
+}
+
+func TestUpdateWorkingHours(t *testing.T) {
+	w := newWorkingHoursRepo()
+
+	day := &pb.WorkingHoursOfDay{Open: "09:00", Close: "22:00", IsWorkDay: true}
+	hours := &pb.WorkingHours{
+		KitchenId: "cdffffd7-67f2-4f96-b0b3-6d6b6bb85724",
+		Monday:    day,
+		Tuesday:   day,
+		Wednesday: day,
+		Thursday:  day,
+		Friday:    day,
+		Saturday:  &pb.WorkingHoursOfDay{Open: "10:00", Close: "02:00", IsWorkDay: true},
+		Sunday:    &pb.WorkingHoursOfDay{},
+	}
+
+	res, err := w.UpdateWorkingHours(context.Background(), hours)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Sunday.IsWorkDay || res.Saturday.Close != "02:00" {
+		t.Errorf("unexpected working hours %+v", res)
+	}
+}
+
+func TestGetOverrides(t *testing.T) {
+	w := newWorkingHoursRepo()
+
+	_, err := w.GetOverrides(context.Background(), "cdffffd7-67f2-4f96-b0b3-6d6b6bb85724")
+	if err != nil {
+		t.Error(err)
+	}
+}
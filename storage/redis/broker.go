@@ -0,0 +1,38 @@
+package redis
+
+import (
+	"context"
+	"order_service/pkg/broker"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// StreamBroker is a broker.Broker appending events to a Redis stream that
+// consumer groups of other services read. The stream is trimmed to about
+// maxLen entries.
+type StreamBroker struct {
+	Client *redis.Client
+	Stream string
+	MaxLen int64
+}
+
+func NewStreamBroker(client *redis.Client, stream string, maxLen int64) *StreamBroker {
+	return &StreamBroker{Client: client, Stream: stream, MaxLen: maxLen}
+}
+
+func (b *StreamBroker) Publish(ctx context.Context, event broker.Event) error {
+	return b.Client.XAdd(ctx, &redis.XAddArgs{
+		Stream: b.Stream,
+		MaxLen: b.MaxLen,
+		Approx: true,
+		Values: map[string]any{
+			"id":             event.Id,
+			"type":           event.Type,
+			"aggregate_type": event.AggregateType,
+			"aggregate_id":   event.AggregateId,
+			"payload":        string(event.Payload),
+			"occurred_at":    event.OccurredAt.Format(time.RFC3339Nano),
+		},
+	}).Err()
+}
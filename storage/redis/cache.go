@@ -0,0 +1,48 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const lookupPrefix = "lookup:"
+
+// LookupCache caches the results of lookups in other services.
+type LookupCache struct {
+	Client *redis.Client
+}
+
+func NewLookupCache(client *redis.Client) *LookupCache {
+	return &LookupCache{Client: client}
+}
+
+func (c *LookupCache) GetMany(ctx context.Context, keys []string) (map[string][]byte, error) {
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = lookupPrefix + key
+	}
+
+	stored, err := c.Client.MGet(ctx, prefixed...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string][]byte{}
+	for i, v := range stored {
+		if s, ok := v.(string); ok {
+			values[keys[i]] = []byte(s)
+		}
+	}
+	return values, nil
+}
+
+func (c *LookupCache) SetMany(ctx context.Context, values map[string][]byte, ttl time.Duration) error {
+	pipe := c.Client.Pipeline()
+	for key, value := range values {
+		pipe.Set(ctx, lookupPrefix+key, value, ttl)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
@@ -0,0 +1,63 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"order_service/pkg/idempotency"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const idempotencyPrefix = "idempotency:"
+
+// IdempotencyStore keeps idempotency records in Redis as JSON values that
+// expire on their own.
+type IdempotencyStore struct {
+	Client *redis.Client
+}
+
+func NewIdempotencyStore(client *redis.Client) *IdempotencyStore {
+	return &IdempotencyStore{Client: client}
+}
+
+func (s *IdempotencyStore) Reserve(ctx context.Context, key string, record idempotency.Record, ttl time.Duration) (*idempotency.Record, bool, error) {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return nil, false, err
+	}
+
+	reserved, err := s.Client.SetNX(ctx, idempotencyPrefix+key, value, ttl).Result()
+	if err != nil || reserved {
+		return nil, reserved, err
+	}
+
+	stored, err := s.Client.Get(ctx, idempotencyPrefix+key).Bytes()
+	if err == redis.Nil {
+		// The record expired in between, try again.
+		return s.Reserve(ctx, key, record, ttl)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	existing := idempotency.Record{}
+	if err := json.Unmarshal(stored, &existing); err != nil {
+		return nil, false, err
+	}
+
+	return &existing, false, nil
+}
+
+func (s *IdempotencyStore) Save(ctx context.Context, key string, record idempotency.Record, ttl time.Duration) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return s.Client.Set(ctx, idempotencyPrefix+key, value, ttl).Err()
+}
+
+func (s *IdempotencyStore) Release(ctx context.Context, key string) error {
+	return s.Client.Del(ctx, idempotencyPrefix+key).Err()
+}
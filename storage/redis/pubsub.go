@@ -0,0 +1,56 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// PubSub is a pubsub.Bus on Redis pub/sub, so messages reach the subscribers
+// of every instance of the service.
+type PubSub struct {
+	Client *redis.Client
+}
+
+func NewPubSub(client *redis.Client) *PubSub {
+	return &PubSub{Client: client}
+}
+
+func (p *PubSub) Publish(ctx context.Context, channel string, message []byte) error {
+	return p.Client.Publish(ctx, channel, message).Err()
+}
+
+func (p *PubSub) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	sub := p.Client.Subscribe(ctx, channel)
+	// Wait for the subscription to be confirmed so no message published after
+	// Subscribe returns is missed.
+	if _, err := sub.Receive(ctx); err != nil {
+		sub.Close()
+		return nil, err
+	}
+
+	messages := make(chan []byte)
+	go func() {
+		defer close(messages)
+		defer sub.Close()
+
+		incoming := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-incoming:
+				if !ok {
+					return
+				}
+				select {
+				case messages <- []byte(msg.Payload):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return messages, nil
+}